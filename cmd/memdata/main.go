@@ -5,11 +5,15 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/reddec/memdata"
 	"github.com/reddec/memdata/generator/model"
+	"io/ioutil"
 	"os"
 )
 
 var config struct {
-	Args struct {
+	Proto   string `short:"p" long:"proto" description:"write protobuf file of models to the path"`
+	GraphQL string `short:"g" long:"graphql" description:"write GraphQL schema of models to the path"`
+	SQL     string `short:"s" long:"sql" description:"write SQL schema (DDL) of storage to the path"`
+	Args    struct {
 		File string `positional-arg-name:"file" description:"path to project YAML file"`
 	} `positional-args:"yes" required:"yes"`
 }
@@ -22,7 +26,6 @@ func main() {
 	project, err := memdata.ReadFile(config.Args.File)
 	if err != nil {
 		panic(err)
-		return
 	}
 	modelGen := model.Generate(project)
 	fs := jen.NewFile(project.Package)
@@ -30,6 +33,23 @@ func main() {
 	err = fs.Render(os.Stdout)
 	if err != nil {
 		panic(err)
-		return
+	}
+	if config.GraphQL != "" {
+		err = ioutil.WriteFile(config.GraphQL, []byte(model.GenerateGraphQL(project)), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if config.SQL != "" {
+		err = ioutil.WriteFile(config.SQL, []byte(model.GenerateSQLSchema(project)), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if config.Proto != "" {
+		err = ioutil.WriteFile(config.Proto, []byte(model.GenerateProto(project)), 0644)
+		if err != nil {
+			panic(err)
+		}
 	}
 }
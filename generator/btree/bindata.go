@@ -68,7 +68,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3c\x5d\x73\xdb\xc6\x76\xcf\xe4\xaf\x38\x57\x9d\xda\x40\x44\x52\x92\xef\xf4\xa1\x74\x98\x99\xc4\x71\x6e\x3d\x4e\x3c\x1e\xdb\x6d\x1f\x5c\x4d\x06\x22\x0e\xc9\x1d\x83\x00\x8b\x5d\x4a\x62\x75\xf9\x7e\x3b\x73\x9f\xfa\x13\xf3\x4b\x3a\x7b\xce\x59\x60\x17\x1f\x14\x15\xcb\x99\xe4\xea\xc5\x26\xb0\x7b\xbe\xbf\xf6\xec\x62\xcf\xce\xe0\x45\xb1\xd9\x95\x6a\xb9\x32\x10\xcd\x63\x78\x76\x7e\xf1\x2f\x23\x78\xb9\x56\x25\xbc\x4d\xb4\x9a\x4f\xe0\xdb\x2c\x03\x7a\xaf\xa1\x44\x8d\xe5\x35\xa6\x93\xe1\xd9\x19\xfc\xbb\x46\x28\x16\x60\x56\x4a\x83\x2e\xb6\xe5\x1c\x61\x5e\xa4\x08\x4a\xc3\xb2\xb8\xc6\x32\xc7\x14\xae\x76\x90\xc0\x77\xef\xbf\x1f\x6b\xb3\xcb\xd0\xce\xca\xd4\x1c\x73\x8d\x60\x56\x89\x81\x79\x92\xc3\x15\xc2\xa2\xd8\xe6\x29\xa8\x1c\xcc\x0a\xe1\xc7\x57\x2f\x5e\xbe\x79\xff\x12\x16\x2a\x43\xc2\xf3\x6d\x9a\x6c\x0c\xa6\xb0\x28\x4a\x42\x30\x5e\x62\x8e\x65\x62\x54\x91\x5b\xf8\x25\xa6\x29\xce\x2d\xdd\xff\x3a\xb4\xc3\xdf\x26\xf3\x4f\xc9\x12\xe1\xca\x94\x88\xa0\xd6\x9b\x0c\xd7\x98\x1b\x6d\x09\x01\xfb\xcc\x02\x25\xb8\xf3\x79\x51\xa6\x2a\x5f\x82\x29\xe0\x75\xbe\x35\xab\xa7\x1a\x52\x5c\xa8\x5c\x59\xd8\x23\x3b\x61\x4c\x40\x8a\x05\x14\x65\x8a\x25\xac\x2d\x73\x09\x41\x81\x9b\x95\x9a\xaf\x40\x27\x46\xe9\x85\x42\x4d\xb4\x2f\x8a\x2c\x2b\x6e\x2c\xc8\x4d\x59\x6c\xb0\x34\x0a\xf5\xd4\xe2\x1a\xc3\xcb\x6b\x2c\x77\x90\x5b\x01\xad\x12\x0d\x89\x81\x75\xa1\x0d\xac\x61\xbe\x52\x59\x5a\x62\x3e\x09\xc7\xe5\xe3\x0c\x93\x05\x4f\x88\xf0\x76\x8e\x1b\x03\x65\x51\x98\xd8\x4d\xcf\x30\xd1\x06\x7e\xf9\xfb\xdf\xd6\x67\xcf\x7e\xf9\xfb\xff\x36\xe1\x7c\x58\x21\x8d\x0f\x87\x9b\x9b\xa2\x1a\x08\x6a\x01\xca\x58\x8e\xf2\xc2\x40\x02\x15\x3e\x81\xf0\x6d\x83\x8a\x1b\x65\x56\xf0\xa9\x9e\x3e\x2f\x72\x93\xa8\x5c\xc3\xa7\x5f\xfe\xf6\x7f\x17\xf0\x09\x77\xda\xcd\xcc\x32\x0b\xed\x1a\x35\x24\x9b\x0d\x26\xa5\xd3\xad\x4e\xd6\x08\x19\x5e\x63\x26\x3a\x78\x6f\xca\xed\xdc\x6c\x4b\x74\x64\x98\x55\x89\x49\x0a\x3a\x59\x54\x7a\x7a\x87\x0b\x2c\x31\x9f\xa3\x9e\xc2\xca\x98\x8d\x9e\x9e\x9d\x61\x3e\xb9\x51\x9f\xd4\x06\x53\x95\x4c\x8a\x72\x79\x66\x7f\x9d\xb1\xba\x86\x1b\xb1\x80\xbb\xbb\x89\x18\xc3\x7e\x3f\x1c\xaa\xf5\xa6\x28\x0d\x44\xc3\xc1\xc9\xd5\xce\xa0\x3e\x19\x0e\x4e\x16\x6b\x63\xff\xd1\xa6\x54\xf9\x52\x9f\x0c\xef\xee\xc6\x50\x26\xf9\x12\x61\xf2\x8a\x86\xeb\xfd\x7e\x08\x00\x70\x72\x77\x37\xd9\xef\x79\x00\xe6\xe9\x7e\x3f\x8c\xc9\xd8\x3e\x58\x4b\x58\x15\x59\xaa\x01\x9d\x9d\x91\x4f\xa0\xd8\xce\xd0\xec\x36\x44\xc9\x87\xdd\x06\xdf\x24\x6b\xdc\xef\x41\x13\xd3\x70\x37\x1c\xbc\xb3\x0a\xe2\xbf\xaf\x82\x31\x6f\xac\xc0\xbd\x3f\x2b\x06\x3b\xd6\x2a\x62\x38\xd0\xea\x7f\xdc\x5b\x95\x1b\x80\xc6\xc8\x0f\x85\x49\x32\xc8\xb7\xeb\x2b\x2c\x2d\x35\x56\x33\x4e\x03\x44\xd3\x60\x5d\x8f\xef\x02\xc0\xc6\x1e\xad\x93\x5b\xb5\xde\xae\x3d\x48\x4e\xf7\xf1\x70\x4f\xec\xbf\x11\x7f\x4f\x40\xab\x7c\x99\xa1\x13\x02\xd9\x8a\x8f\xb1\x2d\x05\x9a\x5a\x4b\xe2\x6d\x52\x22\x51\xd2\x96\xc3\x70\xf0\x32\x37\xa5\x75\x31\xf8\x78\x19\xbe\xb6\x2f\x76\x40\x31\x8c\x6c\x11\xd3\x8a\x59\x96\xd4\x0b\x67\xac\xcd\x99\x2c\x60\x3b\xd3\x8d\xb0\x13\xb4\xf0\xc5\x70\x4b\xdc\xd8\x80\x67\x75\x6a\x19\xf9\x84\xbb\xf1\x75\x92\x6d\x11\x36\x89\x2a\x9d\xf9\x63\xea\x98\x65\x00\x6d\x4e\x19\x58\xcd\xea\x6b\xdc\x01\xd8\x21\xaf\x71\x67\x47\xed\xf7\xc3\xc1\x7f\x10\xdc\xbb\xbb\x09\xfd\x47\x9e\xee\x87\xc3\xe1\x62\x9b\xcf\xe1\x0d\xde\x04\x10\x23\x56\x90\xca\x4d\xdc\x90\x17\xdc\x91\xb9\x2a\x17\xb0\xbe\x86\x3f\xcb\x23\xfb\xb7\x49\x72\x35\x8f\x4e\x5e\xe5\xd7\x49\xa6\x52\x1e\x32\x02\xbd\x2a\xb6\x59\x6a\xe3\x70\x15\x26\xfe\x7c\x12\xd3\xa4\xfd\x70\x50\xa2\xd9\x96\x39\xc0\x93\x00\xcf\xdd\x7a\xca\xd3\xf7\x22\xb1\xb7\x5b\x03\x2a\xd7\x58\x1a\xdd\x14\x14\x05\x0f\x95\x9b\xa2\x32\x07\x0a\x13\xaf\xc8\x30\x21\xc9\xac\xc3\xef\x00\x6f\x95\x36\x7a\x64\xc7\xe4\xa0\x8c\x06\x86\xa0\x34\x6c\x37\x69\x62\x44\xcc\x04\x22\xc7\x1b\x7e\x4b\x70\xac\x38\x85\x85\x24\x5d\x61\x89\x20\x98\xe6\xc5\x7a\x93\x94\x89\x29\xca\xa7\x1a\x48\x2d\x89\xb6\x04\x52\x6c\x2f\xcc\x0a\xcb\x1b\xa5\x11\xd6\x68\x56\x45\xca\xb2\xd1\x13\x16\x78\x44\x11\x3e\x14\x6d\x6c\x79\x8c\x2c\xc9\xbe\xea\x46\x42\x67\xa8\xba\xd8\xea\x19\x49\xef\xd3\x59\x43\x74\x64\x0e\x77\xaf\x71\x37\xb5\xfc\x8f\x80\xa6\x4d\x19\xcc\x7e\x38\x1c\xa8\x05\xcb\x88\xfc\x7d\x36\x83\x5c\x65\x16\xda\xc0\x7b\xd8\x80\x68\x6d\xf9\x4e\x7c\x64\xda\xed\x23\x77\x44\xcc\x7e\x54\x99\x7b\x7b\x1c\x41\xb1\xb6\xc8\x98\x6c\x8c\x39\x3d\x1d\x0e\xc4\x00\x86\x03\x9f\x36\xd6\x74\x54\x91\x34\x02\x82\x1f\xd7\x84\xba\xe9\xce\x3e\xfe\x82\x06\x34\x26\xe5\x7c\x25\xa9\x52\xac\xa2\xb2\x09\x9b\xca\xc9\x1e\xf2\x14\x18\xa5\xf6\xcc\xa0\x28\x49\x0e\x8a\x6d\x46\x72\x45\x5d\x36\x38\x9b\x7a\x8f\xf3\xa2\x9a\x0f\x56\xfb\x6b\x34\xd6\x53\x34\x98\xd2\x5a\x13\xcf\xbf\x49\x34\x4f\xf6\xed\x60\x91\x64\xfa\xb7\xb6\xa8\xbf\x60\xdb\xa2\x62\x88\xba\x4c\x6a\x24\xec\x5e\x15\x45\x46\x72\xb6\x02\x1c\x81\xca\x53\xbc\x75\xef\xa6\x33\x16\x05\x0b\xfa\x1d\xce\xb7\xa5\x56\xd7\x98\xed\x7c\x4d\x7d\xc2\x5d\x4c\x9a\xe4\x39\x77\x95\x8a\x39\xf7\x8b\x1d\x7d\x24\xc0\x97\x4c\xc1\x88\xa4\x67\x95\x59\x0d\x55\xd9\x88\x25\x26\xfa\x7d\x87\xeb\xe2\x1a\xa1\xe4\x7f\x2a\x05\x2f\xca\x62\xdd\x54\xf1\x6f\x2c\x63\xa6\xac\x43\xcc\x8f\x2b\x43\x7a\x9b\x62\x86\x06\x23\x0f\x6c\xec\x3b\xc4\x78\x5c\x3b\xc4\xcb\xf5\xc6\xec\x2a\x4b\x77\xd6\x49\x1c\xa4\x05\xb2\x81\x4b\x8a\x81\x24\xdf\x49\x76\x39\xc0\x27\x01\x8c\x62\x32\x10\x4b\x91\x68\xaa\x42\x6e\x63\xc9\xb9\x20\x7f\x6f\x7f\x3b\xdc\x75\x7a\x27\x1c\xbe\x53\x1e\x14\xac\x05\x12\xc5\x54\x40\x74\xa0\x13\x4c\xaf\x6d\x3a\x76\x98\x92\x2c\x73\xf9\x79\x4c\xd9\xe3\x10\x78\x3b\x33\x8a\xe1\xe3\xa5\xaf\x35\x8b\x89\x20\x4c\x67\xb0\x4e\x3e\x61\x14\xbe\x1e\xd5\xf8\xad\x7e\x4c\xa5\xcd\x57\x06\xc9\xa6\xa2\x78\x38\xb0\xeb\x08\x65\xdf\x9c\x3f\x07\x65\x26\x6f\xf0\xd6\x44\xf1\x73\x50\xa7\xa7\xa4\x48\x0b\xfe\xa3\xba\x84\x99\x7d\xf9\x1a\x77\x76\x4a\x6d\xf8\xf6\xad\xb0\x46\xae\x11\x32\x77\xcd\x8f\x1c\x7b\x70\x95\x68\x4c\xa1\xc8\x5d\xfd\x70\x50\x9e\x0c\xcf\xb1\xec\xb9\xbe\x25\x4b\x20\x07\x6c\x07\xd1\xe1\xf3\x19\x67\x14\x15\xeb\x04\x3d\x64\x9e\x47\x08\xfb\x2f\x32\x5b\xd8\xb3\xc3\x33\xf7\x6c\x3e\x81\xcb\x1f\x64\x98\x20\x44\xe4\x88\x7e\x72\xcb\x55\x36\x1c\x78\x66\x5b\x59\xed\xbf\x21\xad\x52\x2b\x9f\x59\x21\xac\xf8\x91\x94\xdc\xf7\x62\x64\x08\xdd\x46\x6b\xb1\x4f\x56\x32\x40\x30\xfe\x88\x8b\x10\x5f\x86\x0b\x33\xa6\x85\x5b\xb4\x56\x79\xcc\x41\xae\x4e\x4e\xbc\xe4\xd4\x80\xd6\x17\x0f\x52\x62\x21\x47\xcd\xea\x8d\x8a\xd2\x06\x59\x16\x63\x1d\x7a\x7c\xc2\x6c\x08\x3d\x44\x9b\x8d\x78\xbf\x96\x34\xb2\x7b\x88\x4a\xd4\xdb\xcc\xb4\xe3\xa6\x5a\x10\xb6\xca\xca\x98\x9b\xe7\xfc\xf0\x4f\x75\xcd\x22\x8c\xd8\xc7\x55\x62\x39\xbf\xb4\xc0\x3c\xb3\xf2\x58\xe2\x32\xb8\x9b\xa9\x66\x21\xf0\x30\x86\xc4\x9a\x7d\x96\x9a\xf5\xda\x67\x32\x45\xe0\xda\x6c\xbd\x6b\xd9\x2c\xf5\x52\x9c\xa2\x92\xdb\xcf\x30\xa2\x77\x62\xcd\xf7\x5b\x11\xe1\x6c\x9b\x11\x01\x68\xda\x51\x8b\xbe\x5f\x6b\x48\x0e\xfa\x3d\x96\xc4\xbd\x27\x27\x75\xe1\xe9\xb9\x3c\x6e\xcb\x9d\x9e\x57\x82\xcf\x30\x8f\x82\x27\xf1\xf8\xa2\xc7\xc0\x08\x72\xdb\xc2\x3c\x76\x7f\xbd\x89\xd5\xb0\xef\xb5\xb1\x2f\xc0\x6e\x8f\xe9\xbd\xa7\xb6\x46\x9d\xa0\x40\xbb\x07\xb2\xae\xe5\x1e\x9a\x5d\xd7\xcb\x5a\xb6\x84\xc8\x26\x89\x14\xaf\xb6\xcb\x25\xb5\xb1\xb6\xe5\xa6\xd0\xa8\xe3\x83\x85\x00\x81\x8d\x62\x07\x9f\xd2\x55\x09\x57\xdb\xc5\xc2\xe6\xc0\x9d\x41\x3d\xf9\x8e\x7e\x90\x00\x7e\x1e\x01\x96\xa5\x95\x00\x8f\x98\xfc\x67\xa9\x0c\x0a\x90\x93\xef\x3e\x94\x88\xff\x95\x9f\xc4\xcf\x69\x54\x2d\x90\x3d\x4d\xfe\x13\x49\xcd\xd5\x3a\x55\xe1\x55\x6c\xcd\x66\x6b\xa2\x27\x0c\x71\x04\x5e\xad\x76\xce\xd5\x6b\x90\xc8\x04\xb1\x23\xdc\xca\x8b\xf9\xe3\x35\x5b\xc7\x02\xaa\x93\x4b\x81\xb6\x58\x9b\xc9\xfb\x4d\xa9\x72\xb3\x88\x4e\xfe\xf9\xfa\x44\x56\x43\xd6\x0a\x3d\xd0\x9d\xa2\x13\xba\x45\x54\x5f\xf9\xb2\x1a\x71\x5c\x68\xfb\xf6\x88\x3b\x68\x36\x87\x8d\x40\xe9\x0f\x89\xca\xea\x55\x81\x55\x1f\x4a\x8e\x47\xf8\x1a\xac\xc1\xf8\x75\x7d\x7c\x7a\xf1\x1c\x50\x32\xbe\x5a\x84\x63\xdc\xe2\x90\x05\x1b\x48\xf6\xca\xa3\xa9\x1a\xf7\x11\x2f\x85\x98\xd3\x8b\x4a\xca\x56\xcc\x2d\xc8\x0e\x3b\x03\xbe\xcf\x08\xa4\x1f\x37\x79\x87\x1b\x4c\x4c\x74\x42\x1d\x38\xc1\x14\xb7\x0c\x83\x31\xde\x0b\xb4\xad\xa5\x60\xbd\x83\x14\x35\x62\x38\x85\x93\x2e\xeb\x63\x24\x7b\xa9\xdf\x59\xa3\x3d\xfa\x89\x61\xd5\x28\x33\xa4\x4a\xb1\x5a\x61\x0d\x3d\x67\xdd\x32\x78\xf9\x31\x6b\xc8\xf6\xfc\x92\xf0\xf2\x5c\x5a\x89\x53\x82\x6a\xa9\xca\x96\xf5\x4c\xe1\x55\x89\xc9\x27\x47\xa5\x33\x4e\x9e\x7f\x8f\x19\x2a\xfd\x23\x26\x8b\x7e\x86\x1a\x6b\x8a\x1e\x2a\xee\x45\xf2\xc3\x36\xcb\x1e\x8e\xa4\xb2\x9d\x99\xc4\xcc\x75\x72\x2b\xcf\xa2\xfb\xfc\x8b\x97\x98\xef\x37\x99\x32\x9f\x81\xf8\x9b\x07\xe3\x5d\x27\xb7\x4e\x36\xdd\xd5\xe6\xfa\x3e\x00\x2a\xef\x05\xc0\x79\x7c\x0d\xa7\x70\x11\xc3\x19\x3c\x83\xb3\x33\x98\xa3\xca\xa2\xf5\xd9\xb3\x23\x08\xab\x78\xe8\xa4\x2b\x20\x7c\x0c\x17\xf7\xd3\x79\x18\x5e\xc0\xc7\x31\xf0\xd2\x34\x6b\x2f\x2b\x1d\xcb\x63\x8f\xe5\x93\xf1\xc5\x09\x98\x02\x16\xc9\x75\x51\x4a\xfe\xe4\x25\x88\x29\x60\x95\x5c\x23\xac\x8b\x12\x79\xc1\x79\xb3\xc2\x1c\xb4\x35\x03\xa3\xf2\xa5\xe4\x47\x5e\xe2\xd7\x6d\xa9\x22\xcf\x76\x7e\xe7\x5a\x7a\xda\x64\x37\xc9\xba\xc8\x97\xd4\x90\x42\x66\xf7\xa0\xd5\x11\xc4\x3e\x83\xa3\xf6\x41\xb3\xe5\x43\xcd\x02\x0e\xe9\x8d\x3e\x4f\x56\xdc\x8c\x60\xa5\x96\x2b\x8a\x1f\xa3\xb6\x79\x8e\x2f\x38\xdf\xae\x55\x6a\x01\x70\x84\xc9\x8a\x1b\xf8\x7a\xc6\xf3\x6c\x70\xb0\x2f\x67\x10\xd1\xef\x53\xfb\x96\xc4\x48\x4d\xde\xbb\xbb\xb1\x2d\x76\x26\xaf\xf4\x6b\xdc\xbd\xd9\xae\x65\xcb\xc3\xfe\x71\x33\x86\xf2\x89\xa5\x79\x1c\x86\xcc\xb5\x4a\xb9\xd4\x72\x50\x30\xd3\xd4\xc5\x98\xbc\xa8\x9a\x38\xbd\xc0\x26\x2f\xd6\x9b\xa8\x13\x5c\x1c\xc0\xf3\x00\x58\x26\x1d\x10\xcb\xa8\x7b\xae\x6f\x94\x99\xaf\xbc\x3e\xf7\x3c\xd1\xa4\x77\xf8\xa6\x9b\xe0\xe9\xd0\xdf\xf0\x70\x20\x67\x70\xd1\x86\xf0\xf5\xc3\x20\x8c\x6b\x10\x29\x2e\x92\x6d\x66\xfa\x46\x9e\x57\xcf\xf7\x35\xbf\xb4\xb3\x34\x18\x54\x0c\x0d\x06\x44\x88\x9b\xf5\x0d\x9c\x4f\x6d\xa0\xb7\xca\x9d\x91\xbe\x4f\xe1\xa2\x39\xe8\x6b\x19\x44\xaa\xe6\x51\xe3\xf6\x28\x1b\xaf\x69\x98\x38\xd8\x5a\xa5\xae\xcb\x17\xe6\x0f\x32\x3f\xbf\xd3\xd7\x6a\x8c\xd5\x0e\x54\x7a\x0f\xd3\xe2\xc6\x6b\xee\x6a\x93\x94\xd6\xf3\x20\x31\xec\x5a\xf6\x37\x6d\xee\xdc\xeb\x46\x7e\x0b\xae\x9a\x76\xac\x5b\xf5\xba\x60\xbf\xbf\xb9\x1e\xb7\x5f\x63\xfa\x5d\xcf\xf1\x85\x93\x87\x95\x92\xa4\xee\x9a\x1f\xf2\x3e\x2a\xae\xfc\xae\x62\xd0\x54\x94\xfe\x20\x37\x11\xc3\x2e\xa2\xdf\x8a\xad\x1a\x93\xb5\x5a\xea\x06\x7c\x9d\xb0\xe3\x70\x62\x83\x44\x9a\xd5\x59\x5f\x70\x83\x37\x28\x68\xba\xd3\x36\xf7\xfa\x7b\x25\x79\xa0\x64\x8e\x78\x2e\x76\x48\xb7\xc5\x80\x9f\x34\x78\xda\xab\xdc\x14\xd5\x20\xb7\xc3\xe0\x9b\x66\x63\xec\xab\xdc\x60\x99\x27\x59\x38\xfe\x18\xe6\x02\x4c\x8f\xc1\x24\x3d\x79\x5b\x68\x39\x48\xd0\xd5\x59\xf6\xa9\xe4\xa8\x17\x5a\x42\xa3\x19\xef\x03\xbc\x84\x19\xcf\xab\xc5\x56\x1b\xe4\xd9\x19\xbc\xa2\xd1\x3c\xe4\xa9\xe6\x1d\x13\xf6\x45\xce\xae\xae\x5d\xc6\x5b\xa4\x3e\x22\x98\xd1\x86\x7d\x9e\x06\x91\x79\x64\xad\x2a\x1e\x0e\xe6\xc5\x66\x17\x1d\xa0\xeb\xf4\x62\x7a\x39\x6a\xee\x22\xf8\x03\xa6\x97\xf1\xf0\x48\xc6\x58\x52\x55\xdd\x16\x7b\x4a\xdf\xe2\xd1\x4a\x0d\x4c\xe2\x0f\xae\xd8\xb6\xd1\x47\x4d\x87\x0e\x60\x1d\xe9\x01\xfa\x70\x6d\x7c\xe7\xad\xba\x9b\xc5\xb4\xef\xb8\xd5\xfe\x20\xc7\x9a\x99\xb7\x87\x59\xef\x09\xda\x99\xf6\x51\x14\x37\xe6\xd5\xaf\xdf\x14\x39\x8d\x60\xa5\x1f\x41\xba\x3f\xa1\x8f\x03\x31\x7b\xa7\x27\x57\x63\x0e\x07\x1b\x3e\x75\x30\x95\xd8\xc8\x87\x10\x86\xc3\x81\xeb\x03\x1e\xda\x67\x15\x47\xe9\xdc\x6e\x8d\xac\xc3\x34\x5c\x61\xca\x68\x2f\x27\x93\x49\x3c\x02\x46\x35\x05\xa6\xc0\xaa\xd7\x2d\x11\x1f\x13\x27\xa3\xb4\x7e\xd9\x8d\x95\xc2\xc5\x4f\xc5\x35\xd6\xa7\x7a\xaa\xf6\x3d\x09\xd4\x14\x70\x85\x2c\x68\xde\xc2\x27\xc9\xd0\x1e\x6d\x5d\x6f\x7b\x26\xd2\x0a\xeb\xd4\x25\xad\xce\x58\xcc\xfa\x38\xb0\x6c\xfa\x0c\x54\x46\x3d\x75\x2c\x10\x07\xd6\x6c\xa8\xfb\xf5\x19\x10\x43\x99\xd8\x6a\x0b\x0d\xcb\x25\x0a\x88\x1d\x11\xaf\xe1\x80\x10\xf9\x88\x85\x10\x8b\xed\x37\x62\xc3\xcf\xcd\xb8\xc0\x42\xef\xd4\x90\xd4\xbd\x7e\xf4\x16\x9b\xe5\xe0\x6d\x0a\x51\x99\x33\xd9\x76\xc0\x0e\x9f\x07\x21\x3b\x7c\xd5\x19\xb4\x0f\x0e\xa1\xb0\x7d\x70\xc4\xa5\xab\x2e\x1a\x6c\x31\x47\xef\xd1\xb0\x7d\xb1\xf5\x14\x0b\xa8\x22\xab\xe4\x26\xf1\x43\xb7\x05\x5c\x22\x1d\xf8\xa0\xd1\x9c\xa4\x04\x7b\x5f\xac\x83\x19\x0d\x6e\xa2\x2b\xdd\xe6\xd0\x71\xf8\x6a\x93\x6e\x21\x6c\xc9\xb9\xb6\x81\xb6\xa0\x7b\xa8\x3c\x7d\xe6\x49\xba\x6f\xcc\x85\x2f\xec\xde\x41\x96\x61\xa2\x36\x88\x9d\x82\xff\xa8\xb0\xc9\x61\xf8\x50\x70\x7c\xb4\x28\x58\xef\xae\x75\x85\xc2\x47\x0c\x7d\x6d\x44\xa1\xaf\x7f\xb9\x78\x57\x6f\xac\xfc\xca\xa0\x57\x93\xfe\xa8\x91\xaf\x03\xec\x97\x0a\x7f\xee\x84\x22\x9d\x06\xac\xcf\x8b\x16\x39\x4a\x8d\x65\x85\x18\x1c\x3f\x8d\x42\xd9\xda\xd2\x10\x6f\x08\x46\xb7\x29\x0c\x07\x83\xca\x1a\x7a\x4e\x39\xf5\x59\xc0\xe5\x7e\x34\x1c\x0c\xee\x39\xfb\x64\xc9\x11\x9e\xec\xf0\xbd\x78\x80\x54\x05\x36\xc2\x31\x79\x62\xb0\x1d\xcf\x83\x5d\x6c\x79\xe8\x7c\xb1\x96\x22\xf7\xa8\xba\x48\x70\xd1\xa1\xaf\x8e\xb1\x4b\xcb\x9f\x65\x5f\x60\x3a\x93\x93\xaa\x0c\xae\xaa\x2c\x2b\xaa\x5c\xc2\xb8\x6f\x7d\x47\x1b\xcc\xbd\xd5\x53\xf7\xd6\xe2\xe1\x35\x32\x95\xab\xf3\x6d\xe9\xd7\x56\xde\xba\x38\x5c\x01\xca\xb8\x70\x15\x2b\x0f\x65\xf9\xea\x40\xcd\xdc\x73\xbf\x49\x7e\x3f\x83\xbc\xf7\xf9\x47\xe5\x30\xc3\x3c\x6a\x3e\x8c\xc7\x17\x97\xf5\x49\x22\xab\xc0\xf7\xea\x2a\xf3\xf7\xfa\x5c\x40\x7b\xaa\x39\x82\x69\x79\x6f\xbd\x8d\x73\x23\x37\x40\xa2\x2a\x19\xc6\x72\x1c\xdc\x9d\xc3\xac\x8f\x5a\xd9\x58\x32\x1a\x5f\xc4\x16\x97\x9c\xbe\x4b\xf2\x9d\x7f\xb0\x98\x0f\xa9\xcf\xe9\x34\x17\x5e\x23\x9d\xe9\xe7\xa3\x50\x69\x7c\x70\xdb\xd4\x23\xfd\x41\xdd\xd2\xee\x8e\x8e\x71\xcb\x15\xdf\x11\xbc\x2d\x1c\x69\xa7\xb4\x0a\x33\x6f\x78\xdd\x95\xb1\x63\xc7\x63\xd6\x26\xcb\xea\x9b\x19\x9c\xc3\x93\x27\xf2\xcb\xdb\xdc\x7a\x1b\x66\xeb\xb8\xd5\xc9\x69\x8e\x90\xde\x8b\x34\x78\x9a\x1d\x37\x69\xe1\x88\x76\xc9\x7a\x0f\xa8\x97\xf3\xd2\xef\x54\xbf\x3e\xf1\xbf\x47\x05\xbb\x6d\xb5\xdf\x56\xa5\x2c\x3a\xf9\xc7\x0a\x5b\x32\xa4\x93\x74\x62\xdc\x26\xc3\x53\x01\x67\xed\x00\x17\x93\xe3\xbe\x7f\xf8\xa7\xef\x2d\x60\x55\xe4\x87\x34\xe3\x9d\x54\x3c\xdc\x1f\x25\xd6\x1d\xd1\xd6\xc4\xa8\x60\xf2\xbe\x16\x39\xd0\xd3\x13\x1b\x79\x8d\xbb\x6a\x89\xdd\x38\x5e\x4a\x07\x35\xfc\xb3\x93\x52\xcf\x75\x1c\xa0\x2c\xf1\x2a\xc9\x92\x7c\xee\x8e\x57\xd6\xc0\xe3\x7a\x6f\xb4\x55\x00\xf8\xfb\xa3\xad\x43\x66\x14\x7b\xfd\x3e\x44\x9b\xcf\xdc\xca\x80\x7a\x48\xc2\xac\x0d\x59\x3f\x26\xe5\x12\x35\x37\xa2\x9d\xa9\xd5\x59\xa6\x69\x13\x31\xd8\x20\xcd\x53\x82\x63\xcf\x1c\x99\xb7\x57\xfc\x91\x51\x94\x68\xbd\x5d\x63\x6a\x6b\x50\x72\xd2\x38\x40\x46\x82\x79\x45\x5a\x99\xce\x88\xd7\x06\x25\x35\xc7\xd4\xee\xef\x90\xb6\xac\x92\x3a\xe6\x7c\xec\x44\x74\x39\x6c\x68\xf0\x61\xb3\x59\xb9\x2d\xdd\x36\x80\x8c\xa0\x73\x76\x3c\x6c\x6a\xbd\x35\xcf\x37\x00\x89\x95\x6e\x70\xfd\x3f\x5d\xef\x41\x24\x0b\x83\xa5\xe8\xb7\xa0\xaf\x9f\x72\x9c\xa3\xd6\x49\x19\x9e\x3a\x37\xe5\x16\xbb\x0f\x86\xbf\x29\x8c\x7c\xb8\x76\x83\xb0\x50\xa5\x36\xce\x97\x2d\x12\xaf\xcc\x5d\x61\x0e\xf3\x24\xcb\x6a\x3a\x46\x60\x56\x5b\x26\x66\x93\x68\x8d\xa9\x23\x9f\x0f\xbd\x6b\xeb\xdf\xfc\x95\xd3\xe1\x80\x1a\x38\x41\xa7\xe7\x7a\x2a\x6b\x9d\xb6\x3a\x3b\x83\xf9\x0a\xe7\x9f\x2c\xf3\x0e\x94\xb5\x75\xa5\x21\x47\x4c\x31\x0d\x5a\x78\x36\xac\xfe\xf5\xaf\x5d\xfb\xe1\xd5\x5a\xd1\xdb\xfc\x6d\xf6\x02\xcf\xce\xc0\xca\xc3\xae\xa8\x8a\xb2\x2c\x6e\xd8\xa1\xfc\x6a\x84\xed\x5b\xd2\xc3\xc8\x2f\x06\x2a\x3b\xaf\x8e\x40\xfa\x49\xa4\xe1\xfb\x72\x6e\xcf\xe5\x48\xc9\x07\x4f\x9e\x54\x4e\x22\x6f\xda\xfb\xf9\x4d\xf2\xad\x09\x15\x26\x31\x72\x28\xac\xd1\xac\xed\x5f\x71\xf1\xe2\xc3\x4f\x0b\xbe\x67\xf8\x3c\x5d\xee\xc3\xfe\x8f\x5d\x83\xd9\xf0\xb0\x29\xd1\x42\x96\x5c\xfd\x54\x83\x46\xd9\x40\x15\xb3\x32\x85\x4b\xf8\x6e\xff\x39\xa8\xf7\xfb\x11\x8a\xd3\x37\x64\xf0\xb1\x4f\x36\x54\x59\x76\x3b\xad\xa7\xa8\xbe\xb9\x12\x89\x83\xb5\xb1\x37\x52\xd2\xa8\xf7\x84\x8e\xbf\xfd\x54\x68\x43\x31\xd3\xc5\x18\x07\x38\x28\x82\xbb\x5e\x08\xb9\xfd\x10\xbd\x45\x1a\x45\x6f\x11\xda\x71\x8b\xe8\xbb\x5e\xb0\xfb\x46\x53\x51\x96\xd2\xbe\xdc\xe8\xd5\x61\xb9\x79\x5c\xc4\xdd\xc9\xa8\xc3\x81\x82\x7a\x4f\x96\xa2\x15\x06\xff\x57\xe8\x43\xad\x4a\xac\xed\x44\x41\xa5\x19\x7a\x91\xff\xea\x21\x6e\x44\x4d\xb9\x3e\x2f\x6a\x6c\x31\x75\x18\x73\x8b\x9d\xf1\x05\x67\x53\x86\xf0\xf9\xde\xd2\x85\xc0\xb5\xd6\x9a\x1e\x73\xde\xe9\x18\xa1\xfc\xcf\xbb\x1c\xc0\x1f\xe2\x0a\x49\xef\xd1\x8f\xb8\x08\x3d\x20\xc0\x1e\xac\x74\x0f\x4c\x3c\xda\xd0\x83\xc7\xa1\xc5\x04\x00\xbb\xed\xb9\x83\xdd\x0e\xb3\x5d\x63\xb9\x94\xfe\x8f\x18\xaa\xee\xb5\x30\x31\x18\x6f\x4a\xc3\xc2\x1f\xd7\x7a\x8e\x84\xd7\x65\x01\xe2\xe4\x5e\x7e\x9d\x1d\x8d\xf7\x50\xa1\x5b\xad\x4c\x3a\x26\x56\xd5\x2f\x53\x59\x1d\xa5\xea\x5c\x82\xb4\xe6\xcb\x6e\x6c\x3c\xec\xd0\xe4\x61\xcc\xf1\x70\xb0\xaf\x8e\xf3\x74\x64\xd7\xb6\xd6\xc2\xc4\x3e\x10\xbf\xb3\xf6\x7c\x44\xe7\xb6\x23\x9b\x88\xb4\xb1\xa9\x27\x3c\xa4\xf2\x56\xf6\xeb\xd7\x77\x08\xe7\x81\x2a\x6e\xe1\x39\x4a\xbf\xcd\x59\x95\x5e\x24\xfb\x1f\xd6\x6e\x0b\xe7\xb1\xca\xed\x40\xeb\xfc\x34\xf9\xc4\xa5\x2b\xe9\x31\x95\x16\xb8\xbb\x38\x80\xfa\x05\xda\xb5\x24\x6f\x12\x5d\xbf\x92\x22\x57\xc6\xc9\x01\xf9\xd6\xca\x3c\xd8\x05\x96\x4c\x72\x68\x81\x16\xac\xcf\x38\x8a\x85\x2d\x4d\x5e\xb4\x35\x82\x8d\xd0\xb7\xa6\xa8\xb1\xcd\x53\x2c\x17\x74\x66\x49\x17\x2e\x7d\xd6\xcb\x02\xbf\xe6\x6f\x2d\x30\x02\xa1\x35\x16\x17\x07\x4a\xf2\xa6\xf2\x6c\x96\xee\x3d\xa2\x64\x8a\x9e\x57\x94\x18\xaa\xd6\x78\xbf\xd7\x78\xcd\x7d\x87\xa8\x51\x85\x30\x8a\x8e\xc8\x3f\xaf\x82\x7e\x63\x08\xcf\xab\x1b\xd4\x2d\xc0\x6e\xc6\x7d\xa2\x68\x04\xa9\xcf\x90\x44\x2f\x13\x8d\x17\xbd\x52\x78\x04\x6e\x9a\x7e\x7c\x44\x9f\xa4\xeb\x84\x4c\x8a\xb7\x1d\xc7\x62\x52\xbc\x95\xbd\xbe\x3c\x5c\x49\xb7\x0e\x77\x5e\x3a\xc3\x6f\xc4\xb1\xf0\x70\x41\xd7\xc4\xa3\xf8\xab\x03\xc6\x11\xfc\xf9\xbd\xd0\x9e\xaf\x06\x6a\xef\xf4\xa4\x11\x76\x44\x2a\x71\x84\x8f\x7d\x79\x04\x95\x7f\x88\xa5\x29\x11\xcf\x46\x1a\x47\x07\x3a\xe7\xba\xaf\x17\xff\x11\x2e\xbd\x19\xd5\xb7\xde\xb8\xef\x3c\xe9\x32\x12\xba\xd3\x66\x85\xa0\xe4\xa1\xad\x90\x6d\x3d\xde\x71\x39\x45\x35\xaf\xbe\x9f\x82\x8c\xa5\x7d\x25\x89\x9c\x75\xec\xbe\xab\xc4\xdd\x76\xd0\x7a\xf9\x92\x8f\x33\x6d\x64\xeb\x9a\xbe\x06\xb2\x15\xfc\x15\x2e\x55\x3e\x82\x2b\x34\x37\x68\x5d\x12\xe9\x90\xe4\xf9\x08\x2e\x46\xf0\x8c\x6e\xbe\xf0\x99\xf2\x3f\x61\x4a\x0c\x2e\xb6\x59\xc5\x1b\xdc\xac\x0a\x8d\xf5\xed\x2b\x09\x1f\xf9\x3e\xab\x2f\xa0\x38\xfc\x79\x78\xfd\x81\x6c\x8f\x60\xea\x43\xe8\x9d\xef\x69\xcf\x71\x4a\x89\x8e\xad\x7a\xca\xdd\x5e\xc7\xf1\x14\xce\xdd\x46\xcd\x1b\xbc\x35\xc0\x9f\xcb\xfa\xda\x71\xc7\x10\x72\xfb\xda\x5d\xa0\xd2\x6c\x48\xd1\xe7\x68\xf4\x9d\xbc\x4d\xc4\x49\x38\x58\x8c\xa8\xfa\x8a\xcb\xdd\xaf\xc1\x5f\xf8\x36\x1a\x5b\xd4\x9c\xf2\xa7\xcb\x11\x41\x8b\x91\x85\x26\x06\x5a\xa2\x8d\x24\xd7\x6c\xd0\xfc\x19\x9f\x1d\x23\x5f\xb9\x35\x70\x58\xaa\xe6\x49\x96\x89\xc9\xd2\x65\x48\xd4\x20\x33\x6a\x8d\xd5\xad\x1e\x70\xa3\xb2\x0c\x36\x85\xca\x4d\xa7\x08\x78\x4a\xc5\x96\xb7\x61\x41\xe8\x7e\x2a\x52\x55\xdd\xb5\x44\x96\xe0\x8e\x32\x3a\x40\x4e\xd5\x15\xe0\xaf\x3a\x95\x16\x3b\xb2\xdd\xa7\x1f\xcc\x8b\xbb\x8b\x84\x1a\xf0\xe9\x08\x96\x05\x35\x63\x73\x6e\x8b\x55\x38\x2a\x63\x9e\xcd\xe0\x19\x05\xbd\x65\xe1\xc6\xed\x2b\x58\x86\x6d\x3c\xa7\x95\xd2\x12\x4d\xe3\x73\xd6\xaa\xe5\x5f\xdf\xca\xd3\x87\xe3\xbc\x3a\x54\x60\x0b\x83\x6a\x88\xf7\x91\xaa\x6b\x80\x2f\x82\x1b\x43\x3c\xb2\xf8\xf0\xb0\x9b\xc9\x0d\x3e\xb7\x3a\xaf\x1e\x33\x49\xb3\xe6\x97\xad\x8e\x3f\xf1\x55\xe2\x51\xea\xff\x1f\x54\x9e\xba\x2d\x4b\xe1\xa8\x22\x5c\x55\x9b\x9c\xae\x98\x43\xd9\x9a\x09\x39\x90\x3d\x9a\x80\xb8\x11\x84\x44\xc9\x39\xca\x01\xdd\xb8\x44\x35\xdd\xb2\x90\x13\xe6\x72\x84\x27\x3c\xef\x43\x8f\x7c\xb2\xe4\x93\xb4\xd3\x0b\xd9\xe4\x09\xb0\x35\xb7\x79\x9a\x72\xea\x1e\xfc\x11\x4f\xb9\xf9\x74\x0f\x51\xee\xcc\x93\x4f\x93\x5b\xa3\xd3\x97\x1a\x07\xc8\xf9\xc6\x6d\x5d\x1c\x4b\x13\x77\x09\xf6\x42\xd6\x3b\x39\x32\xda\x36\xbc\x80\x4d\xa7\xf7\x10\x66\x60\x00\x0d\x0b\x20\x0c\x36\x4b\x5d\x15\xd7\x74\x41\xc7\xb6\x24\xaf\xe4\xce\x38\x7d\x7b\x53\x62\x32\x5f\x61\xea\xef\x14\x51\x4d\x5e\xd6\x34\x71\x10\x72\x7e\xe0\xcb\x06\x22\xb5\x80\x24\x77\x3b\x3b\x7d\x7a\x6b\x7c\x55\x78\x1c\x3f\x4e\x6d\x6d\x8e\xd8\x77\xdf\x09\xe1\xf5\xc5\x67\xb2\xda\x29\x91\x92\x4b\x5e\xb8\xa6\x92\xd3\x73\xbf\xd9\x11\xc7\xcb\x02\xb6\x1b\x37\xd6\x9d\xca\xa0\xbb\x18\x02\xfa\x3a\xb6\x33\x0f\xea\x5c\x0e\xab\xd6\x7e\xe8\x89\xb3\xcf\x09\x21\xca\x0b\x83\x53\xf7\xe5\x93\xbf\x9d\x2c\xa1\xf7\xbf\xb7\x49\x06\x45\x09\x57\x6a\xb9\x44\x1b\xc7\xdd\xee\x64\xfc\x58\x0e\xfc\x82\x36\x20\xc8\x56\x58\xa8\xaa\xce\x67\xf7\x45\x10\xef\xb3\xd2\xc7\xb0\x84\x5e\x3b\x18\x62\x9e\x4e\x87\x35\x9c\x97\x79\x1a\xd5\x47\xd0\xf9\x4c\xf6\x50\xe6\xf8\xe3\xea\xa8\x0d\x17\xad\x13\xeb\x67\x67\xf0\xb6\xc4\xeb\x03\x15\xc0\xa6\xc4\x6b\x55\x6c\xf5\xd1\x55\x40\x6b\x42\x5f\x25\x60\x11\x77\x57\x02\x4d\x10\x9f\x51\x0d\x3c\x62\x7a\x16\x7a\x7b\xd3\xb3\x9f\x5a\x0b\x3e\xb5\xb7\x54\xf9\x7d\x29\x54\x54\x4d\x23\xfd\x44\xcd\xc9\x5e\x52\xb4\x77\x1f\xc0\x03\x72\x34\xd7\x01\xd5\x49\xc6\xd0\x43\xe4\x23\x7f\x36\x60\x1e\xd3\x4a\xd3\x42\x56\x67\xa2\x76\xbb\x51\x2d\xbb\x3e\xe2\x72\x80\x3f\x4e\xf6\xee\x4a\x94\x7e\xf2\x7e\xcc\xd4\x7d\x4c\xe2\xee\x0c\xeb\x5f\x2c\x73\x1f\x00\x26\x9b\x5c\x1d\x59\xbd\x69\xab\x0f\x08\x7e\xfd\x31\x74\xdc\x97\x1f\x3f\x3f\xe3\xd7\xc1\xe6\x98\xac\x3f\xbe\xe0\x33\x57\x0f\x8a\xe9\xbd\xd4\xff\xda\xec\xde\x6b\x96\xbf\x69\x72\x6f\x48\xee\x8f\x97\xe0\x8f\x64\xe0\x0b\xa9\x7e\x48\xe1\xd5\x4f\xd5\xdf\xd9\x07\x8f\x92\xd4\xdb\x57\xca\x54\x66\x52\xa5\xd4\xfa\xba\xca\xef\xdd\xe5\x6e\x6b\x9b\x2d\x77\x8f\x90\x2b\xdd\xb5\x33\xed\x4b\xc3\x84\xd6\x86\xa6\xf8\xb6\x98\xea\x7a\xb6\x7b\x28\x77\x17\xf6\x3d\x3e\xdd\x5c\x44\x34\x6f\x77\xeb\xa6\xf9\x35\xee\x84\x62\xd2\x1b\xf5\xe4\x4c\xbb\x88\x52\x46\x03\x5d\xdf\x9c\x64\x42\x5c\x54\xe4\x38\xbe\xc2\x45\x51\xe2\x98\x3c\x80\x8e\x39\xbe\x48\xb2\xcc\xb5\x00\x4c\x01\x0b\x34\xf3\x55\x77\x03\x22\xc9\x77\x47\x33\x24\x26\x45\x8d\xd2\x86\x77\x53\xbb\xb2\xcb\xa2\xce\x87\x6d\xf3\xb6\x83\xdd\x3d\xb4\x69\x57\xb9\xb8\xa1\xab\x9c\x6d\x6c\x4a\x3c\x6a\x89\x55\xfb\x6a\x6c\x56\x38\xc6\x3c\xe5\xaa\x8c\x78\x95\x7a\x2a\xe0\x35\x98\xfc\x40\x56\xa9\x20\x7e\x10\xa3\xcf\x0e\x32\xfa\x03\xc9\xbd\xbf\x32\x0e\xf5\x72\x5f\x59\xdc\xd0\x62\x4f\x4d\x4c\x38\xbb\x8b\xe2\x00\xc0\x17\xad\x88\x8f\x15\xb8\x23\xd6\x55\xc4\xbd\x71\xac\x7a\xc1\xe6\xed\x2e\x57\x4b\x0e\x8a\x37\x30\x85\xfb\xa4\x1b\xda\x4d\x8f\x70\x2d\xc2\x6e\xd9\xfa\xd3\x7f\x1f\x8b\x0d\xa1\xb5\x25\xda\x70\xd5\x57\x3d\x66\x5f\x1a\xee\xff\x3f\x00\x00\xff\xff\xb1\x2c\x27\xc5\x3b\x5f\x00\x00")
+var _templateGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xe5\x3d\xdb\x72\xdc\x36\x96\xcf\xea\xaf\x40\xb4\xb5\x49\x77\xd4\xad\x8b\xa7\xf6\x61\x3b\xd6\x54\xc5\x8e\xb3\xeb\x4a\xe2\x72\xd9\xde\xdd\x07\xaf\x2a\x45\x35\xd1\x6a\x96\xd9\x64\x87\x64\x4b\xd6\x6a\xf4\x3e\x5b\x35\x4f\xf3\x89\xf9\x92\x39\x37\x80\x00\x09\xb2\x29\xbb\xed\x72\x76\x5d\x35\x23\x09\x04\x0e\xce\xfd\x1c\xe0\x00\xc8\xc9\x89\x7a\x9a\x6f\x6e\x8b\xe4\x6a\x55\xa9\xf1\x62\xa2\x1e\x9d\x9e\xfd\xcb\x54\x3d\x5b\x27\x85\x7a\x19\x95\xc9\xe2\x58\x7d\x9f\xa6\x8a\xbe\x97\xaa\xd0\xa5\x2e\xae\x75\x7c\x3c\x3a\x39\x51\xff\x51\x6a\x95\x2f\x55\xb5\x4a\x4a\x55\xe6\xdb\x62\xa1\xd5\x22\x8f\xb5\x82\x3f\xaf\xf2\x6b\x5d\x64\x3a\x56\x97\xb7\x2a\x52\x4f\x5e\xff\x30\x2b\xab\xdb\x54\xe3\xa8\x34\x59\xe8\x0c\x46\x56\xab\xa8\x52\x8b\x28\x53\x97\x5a\x2d\xf3\x6d\x16\xab\x24\x83\x46\xad\x7e\x7e\xfe\xf4\xd9\x8b\xd7\xcf\xd4\x32\x49\x35\xcd\xf3\x7d\x1c\x6d\x2a\x80\xb5\xcc\x0b\x9a\x60\x76\xa5\x33\x5d\x44\x55\x92\x67\x08\xbf\xd0\x71\xac\x17\x88\xf7\xbf\x8e\xb0\xfb\xcb\x68\xf1\x2e\xba\xd2\xea\xb2\x2a\x34\x20\xb3\xde\xa4\x7a\xad\x33\x40\x1e\x10\x51\xd8\x86\x40\x09\xee\x62\x91\x17\x71\x92\x5d\xa9\x2a\x57\x3f\x65\xdb\x6a\xf5\x4d\xa9\x62\xbd\x4c\xb2\x04\x61\x4f\x71\xc0\x8c\x80\x00\x95\xd0\x53\x17\x6a\x8d\xc4\x45\x04\x45\xdd\xac\x92\xc5\x4a\x95\x80\x47\xb9\x4c\x74\x49\xb8\x2f\xf3\x34\xcd\x6f\x10\xe4\xa6\xc8\x37\xba\xa8\xe0\xc3\x1c\xe7\x9a\xa9\x67\xc0\x91\x5b\x95\x21\x83\x56\x11\x00\xa9\xd4\x3a\x2f\xe1\xff\xd4\x62\x95\xa4\x71\xa1\xb3\x63\xbf\x5f\x36\x4b\x75\xb4\xe4\x01\x63\xfd\x7e\xa1\x37\x95\x2a\xf2\xbc\x9a\x98\xe1\xf0\x19\xc6\xff\xfe\xb7\xbf\xae\x4f\x1e\xfd\xfe\xb7\xff\x6d\xc2\x79\x03\xe8\x60\x7f\xbf\x7b\x75\x93\xdb\x8e\x2a\x59\xaa\xa4\x42\x8a\x32\xe8\x16\x29\x3b\x9f\x40\xf8\xbe\x81\xc5\x4d\x52\xad\xd4\xbb\x7a\xf8\x22\xcf\xaa\x28\xc9\x4a\xf5\xee\xf7\xbf\xfe\xfd\x4c\xbd\xd3\xb7\xa5\x19\x09\x1a\x03\xe3\xae\x81\x2b\xd1\x66\xa3\xa3\xc2\xc8\xb6\x8c\xd6\x1a\xbe\x5c\xeb\x54\x64\xf0\xba\x2a\xb6\x8b\x6a\x5b\x68\x83\x46\xb5\x2a\x74\x14\x43\xc7\xa5\x95\xd3\x2b\xbd\xd4\x30\xdf\x02\x58\xa9\x56\x55\xb5\x29\xe7\x27\x27\x40\xe6\x4d\xf2\x2e\xd9\xe8\x38\x89\x8e\xf3\xe2\xea\x04\xff\x3a\x61\x71\x8d\xee\xee\x66\x48\x1b\x82\x3b\x7e\xb6\xbe\x44\xfd\x88\xef\xef\x47\x1b\x51\x8c\xbb\xbb\x63\xd1\x11\x68\x1c\x81\x82\xe4\x05\xa8\xfe\xe8\xe0\xf0\xf2\xb6\xd2\xe5\x21\xfc\xb2\x5c\x57\xf8\x23\xa9\x74\x81\x3f\xcb\xaa\x00\x91\xc2\x17\x04\x5c\x44\x19\xc0\x38\x7e\x4e\xc3\x4a\x80\xa0\xe0\xdf\x21\xc0\xbc\xbf\xe7\x0e\x3a\xc3\xc9\x26\xce\xef\x48\xc4\x1b\x54\x99\x55\x9e\xc6\xa5\xd2\x46\x21\xc9\x78\xb4\x28\xd9\xa8\xba\xdd\x10\x6e\x6f\xe0\xe7\x0b\xe0\xd3\xfd\xbd\x2a\x89\x3b\xea\x6e\x74\xf0\x0a\x25\xc9\xff\xbe\xf5\xfa\xbc\x40\xc9\x38\xff\x90\x5f\xd8\x17\x25\x36\x3a\x28\x93\xff\x31\x5f\x93\xac\x52\xaa\xd1\xf3\x4d\x5e\x45\xa9\xca\xb6\xc0\xa3\x02\xb1\x41\x11\x1a\x51\x11\x4e\x07\xeb\xba\x7f\x08\x00\x5b\xc5\x78\x1d\xbd\x4f\xd6\xdb\xb5\x03\xc9\x28\xc9\x64\xc4\xe4\xbf\x10\xc7\x10\xa9\x12\x58\x99\x6a\xc3\x04\x52\x2a\x77\xc6\x36\x17\x68\x68\xcd\x89\x97\x51\xa1\x09\x93\x36\x1f\x46\x07\xcf\x32\x10\x15\x68\x9d\x7a\x7b\xe1\x7f\xc6\x0f\xb7\x88\xf1\x53\x56\x5a\x70\x27\x86\x58\xe6\xd4\x53\xa3\xd5\xcd\x91\xcc\x60\x1c\x69\x7a\xe0\x80\x52\xe8\x62\xb8\x85\xde\xa0\x67\x44\x99\x22\x21\x00\x79\x76\x1d\xa5\x5b\xad\x36\x51\x52\x18\x3b\x81\x29\x85\x58\x06\xd0\xa6\x94\x81\xd5\xa4\xfe\xa4\x6f\x81\x4e\xe8\x02\xbf\x60\x2f\x50\xa5\x83\xff\x24\xb8\xd0\x46\xbf\x48\x2b\xe0\x32\x5a\x6e\xb3\x85\x7a\xa1\x6f\x3c\x88\x63\x16\x10\x88\x6e\xd2\xe0\x17\xc0\x27\xa1\x1a\xcf\xf6\x58\xfd\x49\x9a\xf0\xdf\x26\xca\x92\xc5\xf8\xf0\x79\x06\x64\x24\x31\x77\x99\xaa\x72\x95\x6f\xd3\x18\x1d\xb6\xf5\x27\x7f\x3a\x9c\xd0\x20\xc0\xac\xd0\x60\xc7\x99\x52\x5f\x7b\xf3\xdc\xad\xe7\x3c\xfc\x5e\x38\xf6\x72\x0b\x2e\x07\x02\x00\x18\x4f\x93\x51\xe4\x65\x00\xd7\xdc\xaa\x03\xf9\x93\xe7\xa4\x98\x2a\x4a\xd1\x33\xdc\x2a\xfd\x3e\x29\xab\x72\x8a\x7d\xc0\x85\x01\x14\x86\x00\xca\xb5\xdd\xc4\x51\x25\x6c\x26\x10\x99\xbe\xe1\xaf\x04\x07\xd9\x29\x24\x44\xf1\x0a\x5c\x8a\x92\x99\x16\xf9\x7a\x13\x41\x40\xc9\x0b\x08\x00\x24\x96\xa8\x44\x04\x29\x08\xe4\xd0\xa3\xb8\x49\x20\x62\xad\x75\xb5\xca\x63\xe6\x0d\x78\x3a\x62\xf8\x98\x42\x81\xcf\xda\x09\xd2\x38\x46\x94\x5d\xd1\x4d\x05\x4f\x5f\x74\x13\x94\xb3\x26\xb9\xcf\xcf\x1b\xac\x23\x75\xb8\x03\x00\x73\xa4\x7f\xaa\x68\xd8\x9c\xc1\x00\x2f\x0f\x40\x76\xc4\x23\xb2\xf7\xf3\x73\x95\x25\x29\x42\x3b\x70\x1a\x1b\x10\x51\x97\xef\xc4\x46\xe6\x61\x1b\xb9\x23\x64\x00\x5b\xa3\xee\xf3\xa0\x45\xdc\xa1\x2e\xf2\x4c\xe8\x63\x8e\x8e\xe0\x2f\x56\x80\xd1\x81\x8b\x1b\x4b\x7a\x6c\x51\x9a\x2a\x82\x3f\xa9\x11\x35\xc3\x8d\x7e\xfc\x9b\xae\x54\x09\x21\x63\xb1\x92\x98\x2a\x5a\x61\x75\x02\x63\x3e\xe9\x03\xa4\x0c\x3c\x65\xe9\xa8\x01\xe4\x08\xc8\x87\x84\x75\x46\x82\x4a\x9d\x5f\x18\x9d\x7a\xad\xc1\x2a\xcd\x78\x85\xd2\x07\xe9\xa2\xa5\xc0\x9c\x05\x6a\x13\x8f\xbf\x81\xd8\x49\x83\x5d\x3d\x58\x46\x69\xf9\xb9\x35\x0a\xb8\xd2\xd2\xa8\x89\x1a\x87\x54\x6a\x2a\xe4\x5e\xe6\x79\x4a\x7c\x46\x06\x4e\x81\xfa\x58\xbf\x37\xdf\x40\xd7\x98\xf9\xc4\xe8\x57\x7a\xb1\x2d\xca\x04\xa2\xf2\xad\x2b\x29\x98\x6f\x42\x92\xe4\x31\x77\x56\xc4\x9c\x24\x88\x1e\xbd\x25\xc0\x17\x8c\xc1\x94\xb8\x87\xc2\xb4\x5d\x93\x74\xca\x1c\x13\xf9\xbe\xd2\x6b\x48\x0e\x81\xf3\xf4\xc3\x0a\x78\x59\xe4\xeb\xa6\x88\x3f\x33\x8f\x19\xb3\x00\x9b\xf7\xcb\x43\xfa\x1a\x43\xfc\xab\xf4\xd8\x01\x3b\x71\x0d\x62\x36\xab\x0d\xe2\xd9\x7a\x53\xdd\x5a\x4d\x37\xda\x49\x14\xc4\xb9\x66\x05\x97\x10\x03\x36\x71\x2b\xd1\xa5\x87\x4e\x02\x38\x9e\x90\x82\x20\x46\x22\x29\x3b\x39\xfa\x92\x53\x99\xfc\x35\xfe\x6d\xe6\xae\xc3\x3b\xcd\xe1\x1a\x65\x2f\x63\x11\x08\xcc\x87\x09\x44\x60\x3a\x99\xe9\x27\x0c\xc7\x66\xa6\x08\x12\x48\x89\xcf\x33\x8a\x1e\x7d\xe0\x71\x24\x80\x7f\x7b\xe1\x4a\x0d\x67\x22\x08\x20\xa6\x75\xf4\x4e\x8f\xfd\xcf\xd3\x7a\x7e\x94\x4f\x65\xa5\xf9\xbc\xd2\xa4\x53\x63\x68\xc6\x05\x47\x82\x5f\x4e\xbf\x03\x0f\x73\xfc\x42\xbf\xaf\xc6\x13\xf8\xf5\xe8\x88\x04\x89\xe0\xdf\x26\x17\xe0\x65\xe1\x23\x40\xc6\x21\xb5\xe2\xe3\x57\x21\x8d\x4c\xc3\x27\xee\x9a\x9b\x0c\x79\xea\x32\x2a\x21\x70\xe5\x99\xc9\x1f\x7a\xf9\xc9\xf0\x0c\xc9\x8e\xe9\x23\x5a\x02\xd9\x23\xdb\xf3\x0e\x1f\x4f\x38\x4f\x61\x49\x27\xe8\x3e\xf1\xdc\x43\xc8\x7f\x9a\xe2\x0a\x80\x0d\x9e\xa9\x67\xf5\xf1\x4c\xbe\x97\x60\x82\x30\x26\x43\x74\x83\x1b\xf8\x96\xd1\x81\xa3\xb6\x56\x6b\xff\x5d\xd3\x72\xd6\xda\x0c\xcc\xb1\xe2\x26\x49\xb9\x77\xce\xc8\x10\xc2\x4a\x8b\xb3\x1f\xaf\xa4\x83\xcc\xf8\xb3\x5e\xfa\xf3\xa5\xd0\x30\xa3\x15\xde\x78\x9d\x64\x13\x76\x72\x75\x70\xe2\xb5\x29\xac\x05\xd0\x16\x7b\x31\x41\xc8\xe3\x49\x28\xeb\x6f\xa0\x85\x33\xd6\xae\xc7\x45\x0c\x5d\x68\x1f\x6e\xe8\xf1\x3e\x14\x35\xd2\x7b\x35\x86\xf4\x77\x9b\x56\x6d\xbf\x09\xf0\x70\x36\xab\x65\x4c\xcd\x77\xdc\xf8\x55\x9d\xb3\x08\x21\xd8\x6c\x03\xcb\xe9\x05\x02\x73\xd4\xca\x21\x89\xd3\xe0\x30\x51\xcd\x44\xe0\x61\x04\x89\x36\xbb\x24\x35\xf3\xb5\x8f\x24\x8a\xc0\xb5\xc9\x7a\xd5\xd2\x59\xda\x74\x31\x82\x8a\xde\x7f\x84\x12\xbd\x12\x6d\xde\xad\x45\x34\x67\x5b\x8d\x08\x40\x53\x8f\x5a\xf8\x7d\xa8\x22\x19\xe8\x3b\x34\x89\x37\xa9\x0c\xd7\x85\xa6\xef\xa4\xb9\xcd\x77\x6a\xb7\x8c\x4f\x75\x36\xf6\x5a\x26\xb3\xb3\x0e\x05\x23\xc8\x6d\x0d\x73\xc8\xfd\x70\x15\xab\x61\xef\xd4\xb1\x4f\x40\x6e\x87\xea\xfd\x98\xe6\x79\xe1\x24\xd3\x1c\x09\x70\x95\x87\x74\xf3\xf2\xc4\x2e\xaa\xd2\xa8\xb8\xd2\xc0\x03\x14\x76\xaa\x4b\xe4\x4d\x94\x21\x2f\xf4\x6f\xdb\x28\x35\xd9\xd9\x15\xa4\x42\x99\x4d\xe2\x06\xa4\xdb\x4b\xc2\x61\x47\xc2\xcd\x91\x88\x52\xff\x97\x85\xbe\x86\x5f\x6d\x16\x69\xb1\xb6\x61\x85\xf1\x4e\x70\xa3\xaa\x00\x46\x6f\x00\x03\xdc\x99\x8b\x93\x42\x2f\x30\x4b\xec\x95\x13\xb1\x24\x94\x72\x33\x9e\x5e\x6f\x13\x3a\x5b\x99\x37\xf7\x0d\x04\x58\x32\x64\x2b\x59\xb0\x33\x8e\xb9\xd4\xec\x48\x56\xf2\x4d\x66\xac\x9f\x6f\x4a\xea\xc8\xf9\x25\x6a\x0b\x77\xc2\x51\x46\x21\x82\x38\xde\x21\x8c\x39\x41\x9a\xd2\x7c\x73\xc5\x90\x88\x5b\xf3\x50\x6a\x3f\x55\x9b\xbc\xa4\x2d\xd1\xb9\x3a\xbb\x37\x29\x3e\x2a\xd1\x01\x88\x44\xcb\x96\xcb\xa5\x06\x0a\x44\x14\x38\x4c\x45\x85\x76\xf4\x43\xf2\x1a\xc6\x95\x3b\xfc\x59\x9d\x32\xbe\xc4\x26\xc3\xbc\xf3\x7d\xe1\x0d\xfa\xde\x83\xb9\x5d\xa0\x96\x3f\xeb\x68\x49\xdc\xe4\x45\xe9\xc1\x65\xa1\xa3\x77\xd2\x8b\x04\x72\xce\xc0\xcd\x82\x58\xb8\xe2\xa6\x3b\x2e\x01\x26\xe9\xd1\x49\x8a\xda\x36\xd0\xa8\xca\x35\xe4\x45\xc6\xaa\xae\x00\x03\xb4\x8e\xfd\x18\xd6\x42\x30\xf9\x62\x4c\x4b\x58\x13\x32\x2e\x83\xeb\x30\xf3\x32\xbd\xff\x7f\x18\x98\x65\x7e\x6d\x5e\x9e\xa2\x04\x2c\xec\xb1\xc2\x30\xe0\xce\x28\x3a\x2e\x9c\xdb\xbb\xcd\x7d\x36\x8b\xf3\x09\x40\x9b\xeb\xd8\xf4\xe7\x1a\x83\x6b\x89\x91\x2a\x4d\x83\xec\xda\x72\x29\x09\x77\xad\x65\xa7\xb6\x00\x37\x0f\xda\x12\xeb\xcb\xed\xd5\x15\x55\x73\xb6\x05\xd0\x05\xec\xeb\x5d\xe6\x12\x58\x30\x1c\x81\x4f\x8b\x31\x58\xda\x6d\x97\x4b\x5c\xe1\x61\x71\xe1\xf8\x09\xfd\x41\xe1\xfd\x57\xe0\x63\x51\xa0\xd6\x71\x8f\xe3\xff\x2a\xc0\xd0\x04\xc8\xe1\x13\x2c\x18\xfc\x77\x76\x08\x01\x1f\x7b\xd5\x3a\x7b\x4f\x83\xbf\x22\x56\x9a\x95\xbc\xdd\x56\xc8\xb7\xd5\x66\x5b\x8d\xbf\x66\x88\x53\xe5\xec\x44\x9c\xb2\x30\xbc\x65\x9a\x4c\x6c\x10\x47\x36\x32\x7d\x6c\xdf\x81\xed\xc1\x20\x95\xc6\x0b\xae\xab\xe3\xd7\x1b\x68\xac\x96\xe3\xc3\x7f\xbe\x3e\x14\x3d\x41\x03\x77\x40\x07\x59\x27\x78\x0b\xab\xbe\x75\x79\xc5\x9a\x17\xc8\x5c\xa7\x5c\x48\xc2\x15\xda\x14\xfc\xdd\x9b\x08\xf8\x53\x47\x5e\x74\x9b\xb2\x82\xd5\x21\x3b\x38\x3a\x83\x0f\xb2\x9e\x45\xe3\x76\xfb\x18\xbd\x13\xf5\x74\x39\x7b\xe9\xe0\x54\xeb\xa7\xbe\x10\x64\x8e\xce\x2c\x97\x8d\xce\xeb\x6e\x2b\xdc\xa5\x04\x52\x77\x3a\x7e\xa5\x37\x60\xe7\xe3\x43\xaa\x34\xc9\x4c\x93\x96\x62\xf0\x8c\x3b\x81\xb6\xa5\xe4\x99\xb1\xa6\x9c\x78\xa2\x8e\xd4\x61\x48\xfb\x78\x92\x7b\xde\x9d\x72\x2a\x5c\x2c\xdb\x0e\x49\x4d\xd4\xaa\xb1\x9c\x96\xd5\x38\xca\x87\x65\xf5\x9d\xeb\x9a\xe5\x8f\xa6\x17\x38\xbd\x20\x0c\x78\x2c\xed\x38\xd3\x42\xac\x25\x34\xdc\xbe\x6a\x39\x96\x5a\xe9\x79\xfc\x0e\x85\x74\x9c\x54\x90\xa0\xc6\xde\x59\x07\x16\x3b\x27\xf9\x71\x9b\xa6\x0f\x9f\xc4\x6a\xd1\xb9\x44\x2c\x58\x7c\x49\xdb\x78\x97\xa5\xf1\x56\xea\xeb\x4d\x9a\x54\x1f\x31\xf1\x9f\x1f\x3c\x2f\x74\x35\xbc\x09\xef\xaa\xac\x77\x01\x48\xb2\x4e\x00\xbc\x5e\x5d\x83\xd2\x9e\x4d\xd4\x89\x7a\x84\xe5\x3a\x8c\x10\xe3\xf5\xc9\xa3\x01\x88\x59\x1a\x82\x78\x79\x88\xcf\xd4\xd9\x6e\x3c\xfb\xe1\x79\x74\x0c\x81\x17\xc7\xa9\xee\x24\x79\xe6\x90\x7c\x38\x3b\x3b\xc4\x34\x71\x19\x5d\xe3\x72\x8e\x4c\x8c\xb7\xda\xa0\x71\x15\x41\x36\xb7\xc6\x14\x9d\xb6\x45\x6f\xb0\x7a\x56\xa2\x1a\x54\xe0\x15\x24\x69\xe5\xcc\xa7\x2e\xbf\xe4\x59\x7a\xeb\x56\x68\xa5\x76\x4b\x7a\x13\xad\x73\xf0\xfe\x58\x78\x91\x04\xa5\x57\xeb\xea\x94\x2a\xe8\xc9\x03\xa9\x20\x67\x30\xe4\xdc\x1b\x69\x5f\x9a\xdf\x4c\xd5\x0a\xa8\x23\xff\x31\x6d\xab\xe7\xec\x8c\x23\x2f\xb0\x0e\x01\xb0\x87\x81\x51\xea\xf1\x39\x8f\x43\xe7\x80\x1f\xcf\xd5\x98\xfe\x3e\xc2\xaf\xc4\x46\x2a\x66\x4a\x2a\x71\xfc\xd4\x56\x18\xa4\xcc\x8f\xff\xb8\xec\x40\xb1\x05\x93\xf0\xa7\xeb\x8d\x37\xf9\x5b\x80\xcb\x2e\xd4\x82\xd2\x90\x62\x3b\x00\x10\x33\x03\x04\xb1\x33\xed\x25\x30\x7a\xb1\x72\x8a\xb0\x8b\xa8\x24\x61\x81\xb5\x05\x67\x98\x8f\xdc\x6a\xbc\x01\x79\x0e\x1a\xd5\x82\xf0\xf8\x61\x10\x66\x35\x88\x58\x2f\xa3\x6d\x5a\x75\xf5\x3c\xb5\xed\xf7\x35\xbd\x14\x12\x0e\x0e\x2c\x41\x90\x6f\x22\x22\x66\x14\xac\xfb\xe6\xe8\x9d\x51\x22\xe7\x24\x24\xb0\xdb\x66\xa7\xc7\xd2\x89\xe4\xc3\xbd\x66\xed\x5e\xe8\x64\xe7\x4e\x46\x0e\xdd\xdc\x9c\xb3\x76\xfa\xa4\x33\x6e\x19\xaa\x55\xb5\xa9\xb5\xbe\x70\x1a\xe3\xfc\xc6\xa9\x3c\x96\x55\x54\xa0\xb9\x98\x45\x1c\xfd\x4d\x27\x0f\x76\xea\xbe\x5b\x1f\xb2\xc3\x86\xda\x42\xa7\xdd\x74\x1b\x89\xc9\xb6\xdd\x14\xd1\x2d\xc9\xcd\xce\x0c\x3f\x90\x4b\x12\x6f\x6b\x7a\xc8\x64\x9c\x15\x92\x59\x27\xf4\x2d\x90\xea\x12\x97\x5b\x27\xb4\x55\xb3\x41\x4b\x81\x0e\x14\x87\x2c\x0d\x76\xc4\x5a\x2e\x44\x77\x72\xb2\x27\xe3\x1d\xf3\x58\x1d\xe0\x6e\x8b\x00\xd7\xd3\xf3\xb0\xe7\x59\x95\xdb\x4e\xa6\xfc\xed\xaa\x66\xa3\x2f\xfc\x4f\x17\x59\x94\xfa\xfd\x87\x10\xe7\xcd\xb4\x0f\x22\xa9\xe5\xa5\xac\xe7\xc2\x65\x4f\x17\x4b\xf6\x7a\xbe\x26\x34\x96\x88\x2e\x40\xac\x1e\xd1\xb8\x9a\x6d\xb5\x42\xe2\xb1\x0f\xea\xcd\x5d\xbe\x29\xb9\x9c\xcf\xb6\xc8\x21\xd1\xd4\x72\xf8\xfc\x8e\x3b\x11\x00\xc6\x63\x67\x59\xec\x79\xe6\x29\x6a\x15\xe0\xb7\xc8\x37\xb7\xe3\x1e\xbc\x8e\xce\xe6\x17\x53\xd5\xd3\x61\x7e\x31\x19\x0d\x24\x8c\x39\x65\x93\xad\x89\x23\xf4\xad\x1e\x2c\x54\x4f\x25\xfe\xe0\x82\x6d\x2b\xfd\xb8\x69\xd0\x1e\xac\x81\x16\x50\xf6\x27\xb4\x77\xce\xa2\xb9\x99\x01\xbb\x86\x6b\x0f\xaf\xb0\xaf\x71\xf6\x8f\x9c\x03\x2b\x38\x12\x9b\x70\x9f\xc9\x1b\x57\x7f\x7e\x91\x67\xd4\x83\x85\x3e\x00\x75\x77\x40\x17\x05\xa2\xf6\x46\x4e\x26\x31\x1c\x1d\x6c\xf8\x48\xdc\x5c\x7c\x23\x9f\x90\x03\x7c\x4c\x91\xaa\xef\x10\x90\x18\x4a\xf0\x2c\xd0\x18\x0d\xa6\x61\x0a\x73\x9e\xf6\xe2\xf8\xf8\x18\x3e\xf1\x54\x73\xc5\x18\xa0\x78\xcd\xba\x6e\x9f\x73\xf2\x94\x68\x97\xe1\x59\xc9\x5d\xfc\x82\xbb\x95\xf6\x6c\xaa\xdd\x2c\x23\x86\x42\xfa\x7b\xa9\x99\xd1\x7c\xbe\x8c\x38\x43\x07\x88\xea\x24\xd9\x51\x91\x96\x5b\xa7\x12\x9e\x3d\x00\x78\xde\x45\x01\x92\xe9\x12\x60\x95\x7a\x6e\x48\x20\x0a\x50\x6d\xa8\x34\xf3\x11\x10\x7d\x9e\x58\xdd\xd4\x15\x33\x67\xec\x61\x3c\x25\x82\x03\xbd\x7c\x34\xa6\xcc\x8e\x89\x58\x41\xc3\x4b\xfc\xda\xf4\x10\xcc\xfe\xa0\xac\x24\x03\x76\xfd\xb8\x68\x2f\xbb\x71\x10\x01\x8f\x36\xca\xdb\x76\xdd\x7e\xbb\xe7\xbc\xfd\x4f\x41\xf7\xdd\xdb\x85\x1c\x78\x6f\x8f\x0b\x93\x67\x34\xc8\x62\x8a\x5e\xeb\x8a\x35\x8d\xf5\x28\x5f\x2a\xeb\x63\x25\x4a\x89\x45\x9a\x93\x4a\xb4\x4d\x2b\xbd\x39\x5c\xc9\xec\x5d\x5e\x0f\xa6\xc7\xce\xcd\xe9\x0a\x73\x86\x61\xd8\x7c\xb5\x72\xb7\x26\x6c\xf1\xb9\xd6\x81\x36\xa3\x3b\xb0\x3c\x7a\xe4\x70\xba\xab\xcf\x99\xcb\xec\xce\x4e\x48\x30\x61\xeb\x79\x51\x99\x7f\x90\x03\x65\x87\xdc\xe7\x26\xf7\xe6\x0f\xeb\x43\x20\x21\xa7\xb8\x47\x27\xd8\x9e\xc8\xb7\xfa\x4f\xe7\xf9\xea\xfa\xff\x07\xba\xbf\x1a\xf5\xbd\xfa\xc0\x00\xd8\x4f\xef\x08\xcd\x91\x7a\x3a\xbe\x5e\xdf\x84\xc8\x33\x53\x07\x43\x76\x7a\x17\x2b\xc6\x3e\x97\x31\x5d\xd4\x37\x04\x23\xac\x14\x80\x8f\xd5\x8b\x8e\x63\xb9\x5d\xba\x70\x71\x3f\x85\xd1\x3b\x0e\xeb\x22\x3a\x42\x13\x76\xbf\x17\x5b\x90\x4c\x01\x7d\x1d\xa3\x27\xaa\x1b\x68\xf7\x8e\x5d\x49\xe3\x0e\xab\xb4\xbc\xe5\x2d\xa8\x10\x62\xc6\x7b\x74\x65\x3c\xb8\x08\xfd\x55\x0a\x00\xc0\x39\xbe\x7a\xc1\xe0\x6c\x0e\x6a\x71\x35\x01\x65\xd7\x4a\x90\xce\x49\x75\xe6\x59\xe1\x13\x32\xfd\xab\x69\x4a\x6c\x61\x79\xef\x66\x61\xce\x0a\xda\x5f\x2b\x4a\x3f\x7f\xbd\x2b\x8d\xb2\xd0\x35\xa0\xce\x4d\xbb\xbb\x07\xbe\x9b\x40\x3e\xc2\xf3\x47\xa5\x10\x77\xf3\x9a\x8d\x78\x76\xa5\x3e\x10\x8b\x02\x7c\x9d\x5c\x7a\xe5\x75\xe3\xf0\x60\xa1\x48\xb6\x57\xca\x77\xb4\x41\x8e\x9d\xbc\x55\x32\xb6\xc1\x72\x22\xd7\x9f\xcc\x75\x82\xba\x26\x8e\xbe\x66\x3a\x3b\x9b\xe0\x5c\x72\x88\x1c\xcf\xd5\x3a\xf7\x63\xf8\x52\xd6\x82\x0e\x25\x6b\x2c\xc7\x83\x8f\xe5\x13\xbd\xf1\xe4\x78\x97\xee\x09\xea\x0f\xda\x0c\x0d\xef\xfd\x54\x66\x61\xe3\x1a\x42\xbb\xba\xfd\x6b\x68\x69\x27\xdd\xeb\xfd\x1b\x3a\x32\x31\xf3\xce\x67\x60\x1d\xe5\xeb\xaf\xdb\xb5\xe4\x97\x7e\x34\x9f\xb4\xf6\x7c\x9a\x3d\x6c\x71\x98\x7e\x36\xf7\xe6\x64\xb3\x47\xa4\x4b\xda\xdb\x23\x5e\x8e\x5b\x5f\xa8\x7c\x5d\xe4\xbf\x44\x01\x9b\xaa\xd9\xe7\x15\x29\xb3\x4e\x7e\x20\xb3\xeb\xf3\x23\x5c\x4a\xa8\x4c\x0d\xe1\x1b\x01\x87\x7a\xa0\x97\xc7\xc3\xee\xfb\xfd\xd3\x0f\x08\x18\xf2\xc8\x3e\xc9\x38\x07\xee\xfb\x77\x52\x89\x74\x83\x34\xaa\x18\x25\x54\xce\xed\xc8\x9e\xdd\x3f\xd1\x11\x3c\x27\x39\x3f\x0f\xde\x92\xa0\xf3\x86\xee\x15\x00\xc9\xf7\x02\xf7\x00\x0a\x7d\x19\xa5\x51\xb6\x30\xb7\x04\x6a\xe0\x93\xba\xf4\xd9\x4a\x0b\xdc\xf2\x67\xeb\xac\x34\xf9\x5e\x77\xc7\xa2\x4d\x67\x86\x3c\xa0\xdd\x26\x21\x16\x5d\xd6\xcf\x7c\xe4\xef\x85\x7b\xa8\xa6\x8e\x32\x4d\x9d\x98\x60\x2d\xca\x9c\x12\x74\x6f\xef\xb0\x67\xde\x5e\xf2\xa5\xda\x71\x54\x96\xdb\x35\x2c\x53\x20\x2b\x25\x23\x9d\x78\x93\x11\x63\x9e\x93\x54\xe6\xe7\x44\x6b\x03\x93\x9a\x62\x2a\x0c\x04\xb8\x2d\xab\xa8\xc0\x98\xb7\xc1\x89\x20\xc6\xf8\x12\x7c\xd8\x68\x16\x6e\x4b\xb6\x0d\x20\x53\x15\x1c\x3d\x19\x35\xa5\xde\x1a\xe7\x2a\x80\xf8\x4a\xd3\xb9\xfe\xad\xac\xab\x15\xd1\x12\xcf\x06\xc5\x62\x1c\x74\x38\x46\x43\x87\x32\x2a\xfc\xcb\x53\xb8\x17\xd9\x71\x26\x2c\xaf\xe4\xa2\xf6\x0d\x34\x27\x05\xc8\x53\x6c\xb9\x3e\x04\x46\xc9\x2f\x16\x13\x17\x78\xb2\xdf\xe2\x81\xf7\xf3\xb6\x8c\xcc\x06\xef\xe5\xc4\x06\x7d\xbe\xbb\x85\x55\x16\xb9\xd5\xdb\xef\x50\x3d\x23\x08\x5a\xae\x23\xb2\xd6\xa1\x61\xac\x03\xaf\xf4\xe2\x1d\x12\x6f\x40\xa1\xae\xe3\xbd\x30\xad\x63\x1d\x7b\x9b\x7d\xe8\x56\xff\xf2\x97\x50\xb9\xdb\xae\x25\x9d\xda\xee\x5d\xdb\x96\x90\x1f\xb8\xe2\xca\x8b\x22\xbf\x61\x83\x72\xb3\x11\xd6\x6f\x09\x0f\x53\x37\x19\xb0\x7a\x6e\x4f\xf2\xbb\x41\xa4\x61\xfb\x72\xfc\xdc\xc4\x48\x89\x07\x10\xa8\x8d\x91\xc8\x97\x76\xb9\xbe\x89\x3e\xaa\x50\x5e\x45\x95\x9c\x6d\x6e\x6c\xeb\x76\xaf\xc8\x78\x49\xe2\x86\x05\xd7\x32\x5c\x9a\x60\x79\xe2\xf9\x41\x5c\xa3\xa1\x7b\xd8\x14\x1a\x21\x4b\xac\x86\xb0\x5e\x6a\x29\xb5\x8a\x5a\x01\x1b\x25\xe0\x9b\xf2\xb2\x97\xef\x77\x4f\x28\x46\xdf\xe0\xc1\xdb\x2e\xde\x50\x66\x19\x36\x5a\x47\x50\x5d\x63\xc5\x13\x7b\x6b\x67\xa7\xa7\x84\x51\xa7\x85\x4e\x71\xff\x92\x97\x15\xf9\x4c\xe3\x63\x0c\x60\x2f\x09\x0e\x7d\x10\x74\xbb\x21\x3a\x4b\x37\xf2\xde\xc2\xb4\x61\x8b\xec\xbb\x4e\xb0\xf7\x8d\xed\x47\x59\x6a\xbb\x7c\xa3\x4f\xfd\x7c\x73\xa8\x98\x84\x83\x51\xc0\x80\xbc\x7c\x4f\x16\xa8\x76\x06\xf7\x2f\xdf\x86\x5a\x99\x58\xdb\x88\xbc\x4c\xd3\xb7\x22\xf7\xd3\x43\xcc\x88\x36\xed\x0e\x86\x15\xa3\x02\xca\xdc\x22\x07\xc4\x4d\xe6\xc2\x10\x3e\xde\x5a\x42\x13\x98\xad\xb7\xa6\xc5\x9c\x06\x0d\xc3\xe7\xff\x69\xc8\x00\xdc\x2e\x26\x91\x74\x9a\xf0\xb6\x8c\x67\x01\xde\xec\xde\x4a\xb7\x67\xe0\x60\x45\xf7\x9a\x7d\x8d\xf1\x00\x86\xf5\x39\x40\x6e\x40\x6d\xd7\x1a\xc2\x34\xef\x0a\x89\xa2\x96\x9d\x1a\x26\x0a\xe3\x0c\x69\x68\xf8\x7e\xb5\x67\x20\xbc\x90\x06\x88\x91\x3b\xf1\xf5\x7c\xf0\xbc\x7d\x89\xae\x5d\x99\x04\x06\xda\xec\x97\xb1\xb4\x27\xa5\x82\x4b\x90\xd6\x78\xa9\xdb\x4e\x46\x01\x49\xf6\xcf\x8c\x5b\x7d\x74\x50\x47\x85\xa3\x6b\x5b\x6a\x7e\x60\x3f\x30\xa7\xb4\xe7\xe7\x43\x76\x76\x03\xd1\x44\xb8\xad\x9b\x72\xd2\x7d\x22\x6f\x45\xbf\x6e\x79\xfb\x70\x1e\x28\xe2\xd6\x3c\x83\xe4\xdb\x1c\x65\xe5\x22\xd1\xbf\x5f\xba\xad\x39\x87\x0a\x37\x30\xad\xb1\xd3\xe8\x1d\xa7\xae\x24\xc7\x58\xb6\xc8\xcd\x43\x39\xb4\x5f\x50\x9a\x2d\x49\xbc\x2e\x61\x3f\x49\x92\x2b\xfd\xe4\x9e\x57\x6b\x65\xee\xd5\x8b\x25\x92\xf4\x2d\xd0\xbc\xf5\x19\x7b\x31\x7f\x4b\x93\x17\x6d\x0d\x67\x23\xf8\xad\xc9\x6b\x6c\x81\xc0\x62\x49\xa7\x9b\xca\xdc\x84\xcf\x7a\x59\xe0\xe6\xfc\xa3\xd0\xb2\xd2\x32\xad\xb1\xb8\xe8\x49\xc9\x9b\xc2\xc3\x28\xdd\x79\x98\xa9\xca\x5f\xf4\x54\xb4\xed\x86\xf9\x7c\xc8\xe6\xbf\x99\xa8\x91\x85\xf0\x14\x01\xcf\xbf\xb0\x4e\xbf\xd1\x45\xc6\xf9\x15\x80\x16\x74\x33\x6c\x17\x3f\x1a\x9e\xea\x23\xd8\xd1\x49\x49\xe3\x43\x37\x2b\xf6\x44\x52\xd3\xa2\x07\xec\x98\x84\x4e\xd5\xc0\xf7\xc0\x51\x1a\xdc\x86\x3a\x6b\x9f\xa1\x09\x9c\xe2\xbc\x30\x26\xd0\xf0\x68\xfe\x81\x84\xd0\xc0\x41\xf4\xd5\xae\x63\x00\x7d\xee\xae\x68\xc7\x45\x81\xda\x4e\x1d\x6e\xf8\x7b\x23\x96\x1d\x7e\xb3\xcb\x0f\x6f\x0d\xe0\xcf\xd2\xe4\x88\xa3\x28\x8d\xe3\x06\xc1\xb1\xe6\x66\xda\xff\x85\xe7\xde\xa6\xf5\x7b\x6f\xe6\xb6\x12\xbd\xae\x45\xaf\xb9\x39\x57\xd6\x30\x57\xc6\xcc\x3c\xf0\xda\x92\x1d\x57\x3f\xb8\x44\xca\xd2\x7e\x63\x4b\xce\x47\xaa\x8e\x47\xa7\x38\x05\x6f\x7d\x7c\xc6\x47\xa0\xcc\x7d\x28\xba\x00\x84\xb9\xfc\xa5\xbe\x4a\xc0\x12\x2f\x75\x75\xa3\xd1\x24\x35\x1d\xac\x3c\x9d\xaa\x33\x20\x8a\x9e\x72\x72\x89\x72\x6f\x2d\x01\x1d\xcb\x6d\x5a\x5f\xc7\xbb\x59\xe5\xa5\xae\x9f\x13\x8b\xf8\x6c\xf7\x49\xfd\xa2\x52\xff\x7b\x27\xf5\x7d\xb9\x0e\xc6\xd4\xa7\xcd\x07\x5f\x0f\xc3\x7d\xdf\xfa\x06\xd8\xa9\x29\xd9\x90\x4e\x09\x25\xa5\xfe\x6d\x8b\xfb\x3e\xb2\xe1\xee\xa2\x8b\x3a\x11\x95\xa0\x30\x24\x47\x7e\x19\x43\xb6\xe5\x7b\x29\x01\xf0\x78\x40\xbe\xc2\xbb\x4c\xfa\xb7\x47\x6f\xfd\x97\x3e\xfc\xdb\xd5\x17\xee\x8d\x25\x00\x39\xbe\x4d\x34\xac\x43\xe8\xd7\xbe\x71\x93\xfa\xfc\x5c\xd7\xb3\x19\xfc\x6e\x86\x79\x2f\xc3\xde\xf1\xf9\x8a\x66\x18\x9b\x47\x42\xa6\xce\x9b\x19\xd2\xc9\xba\x0e\xef\x7a\x0d\x32\xee\x49\xb4\x78\x77\x13\x15\xf1\x50\xee\xc5\xfa\x03\xd8\x67\x26\xf9\x22\x78\x98\x60\xa6\x14\xbb\xec\x94\xeb\xa8\xfb\x60\x27\x4a\x46\xf1\x3b\x24\xde\xc5\x56\x39\x38\x93\xe1\x67\xf3\x32\x5d\x73\x8b\x94\xee\xf9\xd3\x03\x44\x98\x1a\x46\x7e\x67\x71\x66\xf6\x02\xa1\x79\xb8\x4c\x54\xc1\xdf\x6a\xa5\xed\x52\x77\xb8\x1c\x6f\xc5\x19\x59\x9e\xe2\x28\x61\x18\x44\xb4\x6b\x76\xac\xfc\x3e\x02\xf6\x11\x6a\x1b\x73\x20\x56\xb8\x07\x2b\xae\x93\x9e\xa3\xa4\x2d\xdb\x2a\x59\x6b\xfb\x5c\x1a\x2c\x5e\xc0\x16\x37\x39\xde\x26\x09\xb1\x80\x87\x58\xb2\x9c\x12\x1a\x4d\xf7\x4b\x1e\x27\xf6\xb5\x4b\xf2\x48\xe6\x18\xae\x01\x64\x34\xcd\x02\xfe\x36\xe8\x3c\x26\x06\x6d\x73\xd7\x88\x69\x31\x8f\xbc\x51\x49\x28\x9e\x42\x64\xa1\xf2\x40\xc6\x1b\xb5\x76\x0e\xeb\x54\x21\x97\x7e\x44\x42\xbf\xca\x4d\xbf\x7b\x0b\xab\x62\x5f\x9b\xd1\xda\xfd\x4a\x57\x8d\x77\x42\x6c\x11\xaa\x7e\xee\xb0\x6b\x8e\x53\x7b\x0c\x06\x95\xd6\x76\x71\x5e\xff\x30\x25\x99\xa5\xf7\x14\x9b\x83\x16\x1f\x7c\x37\x23\xe5\x2c\xbb\xec\x17\xd9\x66\x46\xe9\xbc\xf9\x64\x88\xa1\x4f\x62\x06\xd1\x28\x2b\xd2\x1f\x13\xac\x80\x4a\x39\x9d\x87\x5b\xc4\x13\x5b\x76\x37\xcb\x0b\x2d\xc5\x42\x9f\x02\xa9\x1a\x7a\xc8\x4d\x95\x8f\x94\x9c\x01\x3e\xa0\xa7\x2c\x69\x95\x01\xa2\xe1\xdb\x11\x72\xe8\xcc\x3f\xa1\x46\x4d\x2e\x5a\x72\x1b\xf2\xe8\x4c\xca\x8e\xde\x6c\xcd\xc2\x63\x93\x4f\xe1\xce\x6f\xf1\x30\x11\x76\xdf\x81\x94\x39\xa5\xe7\xe2\x64\x76\x8d\xe8\x6a\x50\x0f\x3a\xf6\x91\x82\xa1\x38\xf1\xbe\xd5\xbd\xa0\xf5\x4a\x8e\x3b\xb7\x15\xcf\x23\xd3\xc8\xdd\x87\xe9\x29\x40\x43\x03\xcc\xed\xf0\xef\x2f\xf1\xf4\x17\x96\xd2\x0a\xb2\x4a\xae\xd5\xd0\x65\x2f\xb0\xa4\xc5\x0a\xbc\x81\x53\xbb\xa4\x55\x62\x51\xe3\xc4\x4e\xc8\x5e\xe6\x77\x78\x03\x06\xbc\xc4\xb2\xf8\xa4\x5f\x6e\x8d\x0b\xad\xc3\xe8\x31\x62\x6b\x53\xc4\xb6\xfb\x4a\x10\xaf\x9f\x9e\x95\xf5\x37\xf8\x5e\x4c\x72\xb2\xdc\x5e\x8a\x17\x39\x77\xab\x1d\x51\x0c\x5a\xb1\xdd\x98\xbe\xe6\x9c\x10\x47\x17\x17\xbf\x40\x81\xbd\x57\xe6\x72\xd0\xba\xb6\x43\x87\x9d\x5d\x46\x88\x97\x79\x2a\xc8\x94\xe4\xaa\x9d\x7b\xc0\x41\x5c\x2f\x3d\x33\x00\xb8\x5d\x26\x57\x57\xe6\x6e\xbf\xb9\x35\xb2\x1f\x03\x7e\x4a\x25\x31\xd2\x15\x66\x6a\x52\xc7\xb3\x5d\x1e\xc4\xb9\xd1\xbc\x0f\x4d\xe8\xd4\x83\x11\x38\xcd\xf9\xa8\x86\x23\x39\x81\x77\x9f\x60\x24\x63\xdc\x7e\xb5\xd7\xc6\xa2\x70\xe3\xb6\x05\x3e\x3f\x0a\xc9\x44\x4f\x06\xb0\x81\xcf\x49\xbe\x2d\x07\x67\x01\xad\x01\x5d\x99\x80\x64\x31\x81\x4c\xa0\x09\xe2\x23\xb2\x81\x3d\x86\x67\xc1\xb7\x33\x3c\xbb\xa1\x35\xe7\x73\xa6\xd0\xb0\x2b\x84\x8a\xa8\xa9\xa7\x1b\xa8\x39\xd8\x4b\x88\x76\x1e\x5a\x7a\x40\x8c\xe6\x3c\xc0\x9e\xbd\xf5\x2d\x44\x5e\x4f\x62\x05\xe6\x3e\xad\x30\x2d\x68\x05\x03\xb5\xa9\x8f\xb6\xf4\x7a\xc0\xab\x4b\x7f\x9c\xe8\x1d\x0a\x94\x6e\xf0\xde\x67\xe8\x1e\x12\xb8\x83\x6e\xfd\x93\x45\xee\x1e\x60\x52\x76\x0d\x44\xf5\xa6\xae\x3e\xc0\xf9\x75\xfb\xd0\xd9\xd9\x27\x8b\xf8\xb5\xb3\x19\x12\xf5\x67\x67\x7c\x0a\xf0\x41\x3e\x7d\xb6\xef\xe8\xde\xa9\x96\x9f\x35\xb8\x37\x38\xf7\xc7\x0b\xf0\x03\x09\xf8\x44\xa2\x1f\x91\x7b\x75\x43\xf5\x13\x6c\xd8\x4b\x50\x6f\xbf\xd5\x67\xd5\xc4\x86\xd4\xfa\x1d\xf0\x1f\xcc\xab\xb9\x6b\x8c\x96\xb7\x7b\x88\x95\xe6\x3d\xbf\xf6\x6b\xac\x82\x6b\x43\x52\xfc\x0c\x9f\x7d\xf7\x76\x07\xe6\xe6\xad\xaf\xfd\xe3\xcd\x49\x44\xf3\xd9\xdc\x30\xce\x58\xf0\x93\x3d\x29\x94\x1b\xed\x0d\x57\xed\x24\x0a\x2b\x68\xf4\x1f\xd0\x00\x1d\x67\xe4\xc6\x79\xa6\x67\xfc\x3a\xdc\x8c\x2c\x80\x0e\xde\x3e\xc5\xd3\x62\xb2\x05\x80\x4f\x56\x68\x7c\x2a\x20\xb8\x01\x01\xee\x68\x30\x41\xa2\x52\xb4\x61\xdf\xb0\x6e\xda\x36\x0f\x69\xd4\xe9\xa8\xad\xde\xd8\xd9\x3c\xf0\x1f\x87\xd2\xc5\x0d\xfd\xc7\x34\xe8\x35\x44\x07\x5b\x22\x15\x3f\xcd\xe0\xd3\x0c\x72\x1a\xce\xca\x88\x56\xc9\xa7\x3c\x5a\xbd\xc1\x0f\x24\x95\x12\xe2\x07\x11\xfa\xa8\x97\xd0\x1f\x89\xef\xdd\x99\xb1\x2f\x97\x5d\x69\x71\x43\x8a\x1d\x39\x31\xcd\x19\x4e\x8a\x3d\x00\x9f\x34\x23\x1e\xca\x70\x83\xac\xc9\x88\x3b\xfd\x98\xfd\xc0\xea\x6d\x5e\xad\x8d\x7a\xd9\xeb\xa9\xc2\x2e\xee\xfa\x7a\xd3\xc1\x5c\x9c\x30\xcc\x5b\x77\xf8\x97\xb1\xd8\x10\x5c\x5b\xac\xf5\x57\x7d\xb6\x99\x6d\x69\x74\xff\x0f\x1b\x07\x98\x10\xbd\x68\x00\x00")
 
 func templateGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -83,7 +83,7 @@ func templateGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template.gotemplate", size: 24379, mode: os.FileMode(420), modTime: time.Unix(1552981252, 0)}
+	info := bindataFileInfo{name: "template.gotemplate", size: 26813, mode: os.FileMode(420), modTime: time.Unix(1792193144, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
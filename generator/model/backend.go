@@ -0,0 +1,191 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"github.com/reddec/memdata/generator/btree"
+	"github.com/reddec/memdata/generator/tree"
+	"strings"
+)
+
+const (
+	backendMap    = "map"
+	backendRBTree = "rbtree"
+	backendBTree  = "btree"
+
+	defaultBTreeOrder = 32
+)
+
+// treeBackend describes ordered storage driver based on generated red-black tree or B-tree
+type treeBackend struct {
+	Prefix string // prefix of generated types and constructors: Tree or BTree
+	Order  int    // order of B-tree (0 for red-black tree)
+}
+
+func projectBackend(proj *memdata.Project) *treeBackend {
+	switch proj.Backend {
+	case "", backendMap:
+		return nil
+	case backendRBTree:
+		return &treeBackend{Prefix: "Tree"}
+	case backendBTree:
+		order := proj.BTreeOrder
+		if order == 0 {
+			order = defaultBTreeOrder
+		}
+		return &treeBackend{Prefix: "BTree", Order: order}
+	}
+	panic("unknown backend " + proj.Backend)
+}
+
+func (backend *treeBackend) TypeName(model *memdata.Model) string {
+	return model.Name + backend.Prefix
+}
+
+// Params of storage constructor (order of B-tree)
+func (backend *treeBackend) Params() []jen.Code {
+	if backend.Order > 0 {
+		return []jen.Code{jen.Id("order").Int()}
+	}
+	return nil
+}
+
+// Args of storage constructor call with default values
+func (backend *treeBackend) Args() []jen.Code {
+	if backend.Order > 0 {
+		return []jen.Code{jen.Lit(backend.Order)}
+	}
+	return nil
+}
+
+// generateTrees embeds ordered tree (by primary key) for each model
+func (backend *treeBackend) generateTrees(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		keyType := model.FieldType(model.Indexed)
+		if strings.Contains(keyType, ".") {
+			panic("key " + model.Name + "." + model.Indexed + " of imported type is not supported by " + proj.Backend + " backend")
+		}
+		comparator := !memdata.IsNumType(keyType) && keyType != "string"
+		var source string
+		if backend.Order > 0 {
+			source = (&btree.BTree{TypeName: backend.TypeName(model), KeyType: keyType, ValueType: "*" + model.Name, Comparator: comparator, Embedded: true}).Generate()
+		} else {
+			source = (&tree.Tree{TypeName: backend.TypeName(model), KeyType: keyType, ValueType: "*" + model.Name, Comparator: comparator, Embedded: true}).Generate()
+		}
+		code.Op(source).Line()
+	}
+	return code
+}
+
+// generateStorages defines ordered implementation of storage for each model
+func (backend *treeBackend) generateStorages(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		treeType := backend.TypeName(model)
+		objName := strings.ToLower(backend.Prefix) + model.Name + "Storage"
+		// define struct { data *Tree }
+		code.Type().Id(objName).Struct(jen.Id("data").Op("*").Id(treeType)).Line()
+		// define constructor
+		code.Func().Id("New" + backend.Prefix + model.Name + "Storage").Params(backend.Params()...).Id(model.Name + "Storage").BlockFunc(func(init *jen.Group) {
+			init.Return().Op("&").Id(objName).Values(jen.Id("data").Op(":").Id("New" + treeType).Call(backend.orderArg()...))
+		}).Line()
+		// PutModel (id, value)
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Put" + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(putFunc *jen.Group) {
+			putFunc.Id("storage").Dot("data").Dot("Put").Call(jen.Id(keyName), jen.Id("item"))
+			generateStorageResult(putFunc, proj)
+		}).Line()
+		// UpdateModel (id, value)
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Update" + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(putFunc *jen.Group) {
+			putFunc.Id("storage").Dot("data").Dot("Put").Call(jen.Id(keyName), jen.Id("item"))
+			generateStorageResult(putFunc, proj)
+		}).Line()
+		// GetModel (id) -> value
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Get" + model.Name).Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
+			getFunc.List(jen.Id("item"), jen.Id("_")).Op(":=").Id("storage").Dot("data").Dot("Get").Call(jen.Id(keyName))
+			getFunc.Return().Id("item")
+		}).Line()
+		// DeleteModel (id)
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Delete" + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType))...).Add(storageError(proj)).BlockFunc(func(delFunc *jen.Group) {
+			delFunc.Id("storage").Dot("data").Dot("Remove").Call(jen.Id(keyName))
+			generateStorageResult(delFunc, proj)
+		}).Line()
+		// IterateModel callback(id, value) -> continue in order of keys
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).BlockFunc(func(iterFunc *jen.Group) {
+			generateTreeIteration(iterFunc, jen.Id("storage").Dot("data"))
+		}).Line()
+		generateStorageIter(code, objName, model)
+		generateTreeOrderedMethods(code, objName, model, func() jen.Code { return jen.Id("storage").Dot("data") }, backend.Order > 0)
+	}
+	return code
+}
+
+// generateTransactionalStorage defines ordered implementation of transactional storage
+func (backend *treeBackend) generateTransactionalStorage(proj *memdata.Project) jen.Code {
+	objName := "mem" + proj.Name + backend.Prefix + "Storage"
+	code := jen.Type().Id(objName).StructFunc(func(store *jen.Group) {
+		for _, model := range proj.Models {
+			store.Id(model.Name).Op("*").Id(backend.TypeName(model))
+		}
+	}).Line()
+	// define constructor
+	code.Func().Id("New" + backend.Prefix + proj.Name + "Storage").Params(backend.Params()...).Id(proj.Name + "TxStorage").BlockFunc(func(init *jen.Group) {
+		init.Return().Op("&").Id(objName).ValuesFunc(func(vals *jen.Group) {
+			for _, model := range proj.Models {
+				vals.Id(model.Name).Op(":").Id("New" + backend.TypeName(model)).Call(backend.orderArg()...)
+			}
+		})
+	}).Line()
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		// GetModel (id) -> value
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Get" + model.Name).Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
+			getFunc.List(jen.Id("item"), jen.Id("_")).Op(":=").Id("storage").Dot(model.Name).Dot("Get").Call(jen.Id(keyName))
+			getFunc.Return().Id("item")
+		}).Line()
+		// IterateModel callback(id, value) -> continue in order of keys
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).BlockFunc(func(iterFunc *jen.Group) {
+			generateTreeIteration(iterFunc, jen.Id("storage").Dot(model.Name))
+		}).Line()
+		generateStorageIter(code, objName, model)
+		generateTreeOrderedMethods(code, objName, model, func() jen.Code { return jen.Id("storage").Dot(model.Name) }, backend.Order > 0)
+	}
+	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Apply").Params(withContext(proj, jen.Id("batch").Index().Id(proj.Name+"LogEntity"))...).Add(storageError(proj)).BlockFunc(func(batchFunc *jen.Group) {
+		batchFunc.For().List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch").BlockFunc(func(batchItem *jen.Group) {
+			for _, model := range proj.Models {
+				batchItem.If(jen.Id("tx").Dot(model.Name).Op("!=").Nil()).BlockFunc(func(modelChange *jen.Group) {
+					modelChange.Switch(jen.Id("tx").Dot(model.Name).Dot("Action")).BlockFunc(func(action *jen.Group) {
+						// put or update
+						action.Case(jen.Id(proj.Name+"ActionInsert"), jen.Id(proj.Name+"ActionUpdate")).BlockFunc(func(operation *jen.Group) {
+							operation.Id("storage").Dot(model.Name).Dot("Put").Call(jen.Id("tx").Dot(model.Name).Dot(model.Indexed), jen.Op("&").Id("tx").Dot(model.Name).Dot("Item"))
+						})
+						// delete
+						action.Case(jen.Id(proj.Name + "ActionDelete")).BlockFunc(func(operation *jen.Group) {
+							operation.Id("storage").Dot(model.Name).Dot("Remove").Call(jen.Id("tx").Dot(model.Name).Dot(model.Indexed))
+						})
+					})
+				})
+			}
+		})
+		generateStorageResult(batchFunc, proj)
+	}).Line()
+	return code
+}
+
+func (backend *treeBackend) orderArg() []jen.Code {
+	if backend.Order > 0 {
+		return []jen.Code{jen.Id("order")}
+	}
+	return nil
+}
+
+// generateTreeIteration passes all items of the tree to the iterator callback in order of keys
+func generateTreeIteration(group *jen.Group, data jen.Code) {
+	group.Id("it").Op(":=").Add(data).Dot("Iterator").Call()
+	group.For(jen.Id("it").Dot("Next").Call()).Block(
+		jen.If(jen.Op("!").Id("iterator").Call(jen.Id("it").Dot("Key").Call(), jen.Id("it").Dot("Value").Call())).Block(jen.Return()),
+	)
+}
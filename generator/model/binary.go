@@ -0,0 +1,404 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Binary representation of item is sequence of fields sorted by name (links are stored as keys): variable-length
+// integers, big-endian floats, length-prefixed strings, slices and maps, presence byte of pointers. Other types should
+// implement encoding.BinaryMarshaler and encoding.BinaryUnmarshaler.
+
+type binaryField struct {
+	Name string // name of struct field
+	Type string
+}
+
+// binaryFields lists fields of model struct (including keys of links) in order of binary representation
+func binaryFields(model *memdata.Model) []binaryField {
+	var fields []binaryField
+	var names []string
+	for name := range model.Fields {
+		names = append(names, name)
+	}
+	for name := range model.Ref {
+		names = append(names, name)
+	}
+	for name := range model.HasMany {
+		names = append(names, name)
+	}
+	for _, name := range names {
+		fieldName, fieldType := validatedField(model, name)
+		fields = append(fields, binaryField{Name: fieldName, Type: fieldType})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
+func decoderType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Decoder"
+}
+
+func isSignedType(typeName string) bool {
+	switch typeName {
+	case "int", "int8", "int16", "int32", "int64", "rune":
+		return true
+	}
+	return false
+}
+
+// splitMapType returns key and value types of map type
+func splitMapType(typeName string) (string, string) {
+	depth := 0
+	for i := len("map"); i < len(typeName); i++ {
+		switch typeName[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return typeName[len("map["):i], typeName[i+1:]
+			}
+		}
+	}
+	panic("invalid map type " + typeName)
+}
+
+// isCustomType checks that value of type is encoded by its own MarshalBinary method
+func isCustomType(typeName string) bool {
+	switch {
+	case typeName == "bool" || typeName == "string" || memdata.IsNumType(typeName):
+		return false
+	case strings.HasPrefix(typeName, "[]") || strings.HasPrefix(typeName, "*") || strings.HasPrefix(typeName, "map["):
+		return false
+	}
+	return true
+}
+
+// isMarshaler checks that value of type or nested values are encoded by own MarshalBinary method
+func isMarshaler(typeName string) bool {
+	switch {
+	case isCustomType(typeName):
+		return true
+	case strings.HasPrefix(typeName, "[]"):
+		return isMarshaler(typeName[2:])
+	case strings.HasPrefix(typeName, "*"):
+		return isMarshaler(typeName[1:])
+	case strings.HasPrefix(typeName, "map["):
+		key, value := splitMapType(typeName)
+		return isMarshaler(key) || isMarshaler(value)
+	}
+	return false
+}
+
+// typeCode renders type of field with qualified imports in nested types
+func typeCode(proj *memdata.Project, typeName string) jen.Code {
+	switch {
+	case strings.HasPrefix(typeName, "[]"):
+		return jen.Index().Add(typeCode(proj, typeName[2:]))
+	case strings.HasPrefix(typeName, "*"):
+		return jen.Op("*").Add(typeCode(proj, typeName[1:]))
+	case strings.HasPrefix(typeName, "map["):
+		key, value := splitMapType(typeName)
+		return jen.Map(typeCode(proj, key)).Add(typeCode(proj, value))
+	}
+	return proj.Qual(typeName)
+}
+
+func depthName(name string, depth int) string {
+	if depth == 0 {
+		return name
+	}
+	return name + strconv.Itoa(depth)
+}
+
+// generateAppendValue appends binary representation of value to data. Errors of marshalers are returned from the
+// enclosing function (err should be declared).
+func generateAppendValue(group *jen.Group, proj *memdata.Project, value *jen.Statement, typeName string, depth int) {
+	data := jen.Id("data")
+	appendLength := func(length jen.Code) {
+		group.Add(data.Clone()).Op("=").Qual("encoding/binary", "AppendUvarint").Call(data.Clone(), jen.Uint64().Call(length))
+	}
+	switch {
+	case typeName == "bool":
+		group.If(value.Clone()).Block(
+			data.Clone().Op("=").Append(data.Clone(), jen.Lit(1)),
+		).Else().Block(
+			data.Clone().Op("=").Append(data.Clone(), jen.Lit(0)),
+		)
+	case typeName == "float64":
+		group.Add(data.Clone()).Op("=").Qual("encoding/binary", "BigEndian").Dot("AppendUint64").Call(data.Clone(), jen.Qual("math", "Float64bits").Call(value.Clone()))
+	case typeName == "float32":
+		group.Add(data.Clone()).Op("=").Qual("encoding/binary", "BigEndian").Dot("AppendUint32").Call(data.Clone(), jen.Qual("math", "Float32bits").Call(value.Clone()))
+	case isSignedType(typeName):
+		group.Add(data.Clone()).Op("=").Qual("encoding/binary", "AppendVarint").Call(data.Clone(), jen.Int64().Call(value.Clone()))
+	case memdata.IsNumType(typeName):
+		group.Add(data.Clone()).Op("=").Qual("encoding/binary", "AppendUvarint").Call(data.Clone(), jen.Uint64().Call(value.Clone()))
+	case typeName == "string" || typeName == "[]byte":
+		appendLength(jen.Len(value.Clone()))
+		group.Add(data.Clone()).Op("=").Append(data.Clone(), value.Clone().Op("..."))
+	case strings.HasPrefix(typeName, "[]"):
+		item := depthName("item", depth)
+		appendLength(jen.Len(value.Clone()))
+		group.For(jen.List(jen.Id("_"), jen.Id(item)).Op(":=").Range().Add(value.Clone())).BlockFunc(func(iter *jen.Group) {
+			generateAppendValue(iter, proj, jen.Id(item), typeName[2:], depth+1)
+		})
+	case strings.HasPrefix(typeName, "map["):
+		key, val := splitMapType(typeName)
+		keyName, valueName := depthName("key", depth), depthName("value", depth)
+		appendLength(jen.Len(value.Clone()))
+		if !isOrderedType(key) {
+			group.For(jen.List(jen.Id(keyName), jen.Id(valueName)).Op(":=").Range().Add(value.Clone())).BlockFunc(func(iter *jen.Group) {
+				generateAppendValue(iter, proj, jen.Id(keyName), key, depth+1)
+				generateAppendValue(iter, proj, jen.Id(valueName), val, depth+1)
+			})
+			return
+		}
+		// keys of builtin types are sorted for stable representation (in own block: several maps in one function)
+		keys := depthName("keys", depth)
+		group.BlockFunc(func(sorted *jen.Group) {
+			sorted.Id(keys).Op(":=").Make(jen.Index().Id(key), jen.Lit(0), jen.Len(value.Clone()))
+			sorted.For(jen.Id(keyName).Op(":=").Range().Add(value.Clone())).Block(
+				jen.Id(keys).Op("=").Append(jen.Id(keys), jen.Id(keyName)),
+			)
+			sorted.Qual("sort", "Slice").Call(jen.Id(keys), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+				jen.Return(jen.Id(keys).Index(jen.Id("i")).Op("<").Id(keys).Index(jen.Id("j"))),
+			))
+			sorted.For(jen.List(jen.Id("_"), jen.Id(keyName)).Op(":=").Range().Id(keys)).BlockFunc(func(iter *jen.Group) {
+				iter.Id(valueName).Op(":=").Add(value.Clone()).Index(jen.Id(keyName))
+				generateAppendValue(iter, proj, jen.Id(keyName), key, depth+1)
+				generateAppendValue(iter, proj, jen.Id(valueName), val, depth+1)
+			})
+		})
+	case strings.HasPrefix(typeName, "*"):
+		group.If(value.Clone().Op("==").Nil()).Block(
+			data.Clone().Op("=").Append(data.Clone(), jen.Lit(0)),
+		).Else().BlockFunc(func(present *jen.Group) {
+			present.Add(data.Clone()).Op("=").Append(data.Clone(), jen.Lit(1))
+			elem := typeName[1:]
+			if isCustomType(elem) {
+				generateAppendMarshaler(present, proj, value.Clone())
+			} else {
+				generateAppendValue(present, proj, jen.Parens(jen.Op("*").Add(value.Clone())), elem, depth+1)
+			}
+		})
+	default:
+		generateAppendMarshaler(group, proj, jen.Op("&").Add(value.Clone()))
+	}
+}
+
+func generateAppendMarshaler(group *jen.Group, proj *memdata.Project, pointer jen.Code) {
+	group.If(
+		jen.List(jen.Id("data"), jen.Err()).Op("=").Id("append"+proj.Name+"Marshaler").Call(jen.Id("data"), pointer),
+		jen.Err().Op("!=").Nil(),
+	).Block(jen.Return(jen.Nil(), jen.Err()))
+}
+
+// generateDecodeValue restores value from binary representation read by decoder
+func generateDecodeValue(group *jen.Group, proj *memdata.Project, target *jen.Statement, typeName string, depth int) {
+	decoder := jen.Id("decoder")
+	switch {
+	case typeName == "bool":
+		group.Add(target.Clone()).Op("=").Add(decoder.Clone()).Dot("bool").Call()
+	case typeName == "float64":
+		group.Add(target.Clone()).Op("=").Qual("math", "Float64frombits").Call(decoder.Clone().Dot("uint64").Call())
+	case typeName == "float32":
+		group.Add(target.Clone()).Op("=").Qual("math", "Float32frombits").Call(decoder.Clone().Dot("uint32").Call())
+	case isSignedType(typeName):
+		group.Add(target.Clone()).Op("=").Id(typeName).Call(decoder.Clone().Dot("varint").Call())
+	case memdata.IsNumType(typeName):
+		group.Add(target.Clone()).Op("=").Id(typeName).Call(decoder.Clone().Dot("uvarint").Call())
+	case typeName == "string":
+		group.Add(target.Clone()).Op("=").String().Call(decoder.Clone().Dot("bytes").Call())
+	case typeName == "[]byte":
+		group.Add(target.Clone()).Op("=").Append(jen.Index().Byte().Call(jen.Nil()), decoder.Clone().Dot("bytes").Call().Op("..."))
+	case strings.HasPrefix(typeName, "[]"):
+		item, count := depthName("item", depth), depthName("n", depth)
+		group.Add(target.Clone()).Op("=").Nil()
+		group.For(jen.Id(count).Op(":=").Add(decoder.Clone()).Dot("length").Call(), jen.Id(count).Op(">").Lit(0), jen.Id(count).Op("--")).BlockFunc(func(iter *jen.Group) {
+			iter.Var().Id(item).Add(typeCode(proj, typeName[2:]))
+			generateDecodeValue(iter, proj, jen.Id(item), typeName[2:], depth+1)
+			iter.Add(target.Clone()).Op("=").Append(target.Clone(), jen.Id(item))
+		})
+	case strings.HasPrefix(typeName, "map["):
+		key, val := splitMapType(typeName)
+		keyName, valueName, count := depthName("key", depth), depthName("value", depth), depthName("n", depth)
+		group.Add(target.Clone()).Op("=").Nil()
+		group.For(jen.Id(count).Op(":=").Add(decoder.Clone()).Dot("length").Call(), jen.Id(count).Op(">").Lit(0), jen.Id(count).Op("--")).BlockFunc(func(iter *jen.Group) {
+			iter.Var().Id(keyName).Add(typeCode(proj, key))
+			iter.Var().Id(valueName).Add(typeCode(proj, val))
+			generateDecodeValue(iter, proj, jen.Id(keyName), key, depth+1)
+			generateDecodeValue(iter, proj, jen.Id(valueName), val, depth+1)
+			iter.If(target.Clone().Op("==").Nil()).Block(
+				target.Clone().Op("=").Make(typeCode(proj, typeName)),
+			)
+			iter.Add(target.Clone()).Index(jen.Id(keyName)).Op("=").Id(valueName)
+		})
+	case strings.HasPrefix(typeName, "*"):
+		elem := typeName[1:]
+		group.If(decoder.Clone().Dot("bool").Call()).BlockFunc(func(present *jen.Group) {
+			present.Add(target.Clone()).Op("=").New(typeCode(proj, elem))
+			if isCustomType(elem) {
+				present.Add(decoder.Clone()).Dot("unmarshal").Call(target.Clone())
+			} else {
+				generateDecodeValue(present, proj, jen.Parens(jen.Op("*").Add(target.Clone())), elem, depth+1)
+			}
+		}).Else().Block(
+			target.Clone().Op("=").Nil(),
+		)
+	default:
+		group.Add(decoder.Clone()).Dot("unmarshal").Call(jen.Op("&").Add(target.Clone()))
+	}
+}
+
+// generateBinaryHelpers defines decoder of binary representation and appending of values with own marshalers
+func generateBinaryHelpers(proj *memdata.Project) jen.Code {
+	typeName := decoderType(proj)
+	errTrailing := "err" + proj.Name + "TrailingData"
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("decoder").Op("*").Id(typeName)) }
+	fail := jen.Id("decoder").Dot("err").Op("=").Qual("io", "ErrUnexpectedEOF")
+	failed := jen.Id("decoder").Dot("err").Op("!=").Nil()
+
+	code := jen.Var().Id(errTrailing).Op("=").Qual("errors", "New").Call(jen.Lit("trailing data after binary item")).Line()
+	code.Func().Id("append"+proj.Name+"Marshaler").Params(jen.Id("data").Index().Byte(), jen.Id("value").Qual("encoding", "BinaryMarshaler")).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.List(jen.Id("raw"), jen.Err()).Op(":=").Id("value").Dot("MarshalBinary").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Id("data").Op("=").Qual("encoding/binary", "AppendUvarint").Call(jen.Id("data"), jen.Uint64().Call(jen.Len(jen.Id("raw")))),
+		jen.Return(jen.Append(jen.Id("data"), jen.Id("raw").Op("...")), jen.Nil()),
+	).Line()
+	code.Comment(typeName + " reads binary representation of items. The first error stops reading").Line()
+	code.Type().Id(typeName).Struct(
+		jen.Id("data").Index().Byte(),
+		jen.Id("err").Error(),
+	).Line()
+	code.Func().Add(recv()).Id("uvarint").Params().Uint64().Block(
+		jen.If(failed.Clone()).Block(jen.Return(jen.Lit(0))),
+		jen.List(jen.Id("value"), jen.Id("n")).Op(":=").Qual("encoding/binary", "Uvarint").Call(jen.Id("decoder").Dot("data")),
+		jen.If(jen.Id("n").Op("<=").Lit(0)).Block(fail.Clone(), jen.Return(jen.Lit(0))),
+		jen.Id("decoder").Dot("data").Op("=").Id("decoder").Dot("data").Index(jen.Id("n"), jen.Empty()),
+		jen.Return(jen.Id("value")),
+	).Line()
+	code.Func().Add(recv()).Id("varint").Params().Int64().Block(
+		jen.If(failed.Clone()).Block(jen.Return(jen.Lit(0))),
+		jen.List(jen.Id("value"), jen.Id("n")).Op(":=").Qual("encoding/binary", "Varint").Call(jen.Id("decoder").Dot("data")),
+		jen.If(jen.Id("n").Op("<=").Lit(0)).Block(fail.Clone(), jen.Return(jen.Lit(0))),
+		jen.Id("decoder").Dot("data").Op("=").Id("decoder").Dot("data").Index(jen.Id("n"), jen.Empty()),
+		jen.Return(jen.Id("value")),
+	).Line()
+	// fixed-size and length-prefixed values
+	code.Func().Add(recv()).Id("next").Params(jen.Id("size").Uint64()).Index().Byte().Block(
+		jen.If(failed.Clone()).Block(jen.Return(jen.Nil())),
+		jen.If(jen.Id("size").Op(">").Uint64().Call(jen.Len(jen.Id("decoder").Dot("data")))).Block(fail.Clone(), jen.Return(jen.Nil())),
+		jen.Id("value").Op(":=").Id("decoder").Dot("data").Index(jen.Empty(), jen.Id("size")),
+		jen.Id("decoder").Dot("data").Op("=").Id("decoder").Dot("data").Index(jen.Id("size"), jen.Empty()),
+		jen.Return(jen.Id("value")),
+	).Line()
+	code.Func().Add(recv()).Id("bool").Params().Bool().Block(
+		jen.Id("value").Op(":=").Id("decoder").Dot("next").Call(jen.Lit(1)),
+		jen.Return(jen.Len(jen.Id("value")).Op("==").Lit(1).Op("&&").Id("value").Index(jen.Lit(0)).Op("!=").Lit(0)),
+	).Line()
+	code.Func().Add(recv()).Id("uint32").Params().Uint32().Block(
+		jen.If(jen.Id("value").Op(":=").Id("decoder").Dot("next").Call(jen.Lit(4)), jen.Len(jen.Id("value")).Op("==").Lit(4)).Block(
+			jen.Return(jen.Qual("encoding/binary", "BigEndian").Dot("Uint32").Call(jen.Id("value"))),
+		),
+		jen.Return(jen.Lit(0)),
+	).Line()
+	code.Func().Add(recv()).Id("uint64").Params().Uint64().Block(
+		jen.If(jen.Id("value").Op(":=").Id("decoder").Dot("next").Call(jen.Lit(8)), jen.Len(jen.Id("value")).Op("==").Lit(8)).Block(
+			jen.Return(jen.Qual("encoding/binary", "BigEndian").Dot("Uint64").Call(jen.Id("value"))),
+		),
+		jen.Return(jen.Lit(0)),
+	).Line()
+	code.Func().Add(recv()).Id("bytes").Params().Index().Byte().Block(
+		jen.Return(jen.Id("decoder").Dot("next").Call(jen.Id("decoder").Dot("uvarint").Call())),
+	).Line()
+	// number of elements: each element takes at least one byte
+	code.Func().Add(recv()).Id("length").Params().Int().Block(
+		jen.Id("length").Op(":=").Id("decoder").Dot("uvarint").Call(),
+		jen.If(jen.Id("length").Op(">").Uint64().Call(jen.Len(jen.Id("decoder").Dot("data")))).Block(fail.Clone(), jen.Return(jen.Lit(0))),
+		jen.Return(jen.Int().Call(jen.Id("length"))),
+	).Line()
+	code.Func().Add(recv()).Id("unmarshal").Params(jen.Id("value").Qual("encoding", "BinaryUnmarshaler")).Block(
+		jen.Id("data").Op(":=").Id("decoder").Dot("bytes").Call(),
+		jen.If(failed.Clone()).Block(jen.Return()),
+		jen.Id("decoder").Dot("err").Op("=").Id("value").Dot("UnmarshalBinary").Call(jen.Id("data")),
+	).Line()
+	code.Func().Add(recv()).Id("finish").Params().Error().Block(
+		jen.If(jen.Id("decoder").Dot("err").Op("==").Nil().Op("&&").Len(jen.Id("decoder").Dot("data")).Op(">").Lit(0)).Block(
+			jen.Return(jen.Id(errTrailing)),
+		),
+		jen.Return(jen.Id("decoder").Dot("err")),
+	).Line()
+	return code
+}
+
+// generateModelBinary defines binary marshaling of model
+func generateModelBinary(model *memdata.Model) jen.Code {
+	proj := model.Project
+	fields := binaryFields(model)
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("model").Op("*").Id(model.Name)) }
+	code := jen.Comment("AppendBinary appends binary representation of item (links are stored as keys) to data").Line()
+	code.Func().Add(recv()).Id("AppendBinary").Params(jen.Id("data").Index().Byte()).Params(jen.Index().Byte(), jen.Error()).BlockFunc(func(appendFunc *jen.Group) {
+		for _, field := range fields {
+			if isMarshaler(field.Type) {
+				appendFunc.Var().Err().Error()
+				break
+			}
+		}
+		for _, field := range fields {
+			generateAppendValue(appendFunc, proj, jen.Id("model").Dot(field.Name), field.Type, 0)
+		}
+		appendFunc.Return(jen.Id("data"), jen.Nil())
+	}).Line()
+	code.Comment("MarshalBinary returns binary representation of item").Line()
+	code.Func().Add(recv()).Id("MarshalBinary").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Return().Id("model").Dot("AppendBinary").Call(jen.Nil()),
+	).Line()
+	code.Comment("UnmarshalBinary restores item from binary representation").Line()
+	code.Func().Add(recv()).Id("UnmarshalBinary").Params(jen.Id("data").Index().Byte()).Error().Block(
+		jen.Id("decoder").Op(":=").Op("&").Id(decoderType(proj)).Values(jen.Id("data").Op(":").Id("data")),
+		jen.Id("model").Dot("decodeBinary").Call(jen.Id("decoder")),
+		jen.Return().Id("decoder").Dot("finish").Call(),
+	).Line()
+	code.Func().Add(recv()).Id("decodeBinary").Params(jen.Id("decoder").Op("*").Id(decoderType(proj))).BlockFunc(func(decodeFunc *jen.Group) {
+		for _, field := range fields {
+			generateDecodeValue(decodeFunc, proj, jen.Id("model").Dot(field.Name), field.Type, 0)
+		}
+	}).Line()
+	return code
+}
+
+// generateLogEntityBinary defines binary marshaling of change of model: key, item and action
+func generateLogEntityBinary(model *memdata.Model) jen.Code {
+	proj := model.Project
+	typeName := model.Name + "LogEntity"
+	keyType := model.FieldType(model.Indexed)
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("entity").Op("*").Id(typeName)) }
+	code := jen.Comment("AppendBinary appends binary representation of change to data").Line()
+	code.Func().Add(recv()).Id("AppendBinary").Params(jen.Id("data").Index().Byte()).Params(jen.Index().Byte(), jen.Error()).BlockFunc(func(appendFunc *jen.Group) {
+		if isMarshaler(keyType) {
+			appendFunc.Var().Err().Error()
+		}
+		generateAppendValue(appendFunc, proj, jen.Id("entity").Dot(model.Indexed), keyType, 0)
+		appendFunc.Add(jen.Id("data").Op("=").Qual("encoding/binary", "AppendVarint").Call(jen.Id("data"), jen.Int64().Call(jen.Id("entity").Dot("Action"))))
+		appendFunc.Return().Id("entity").Dot("Item").Dot("AppendBinary").Call(jen.Id("data"))
+	}).Line()
+	code.Comment("MarshalBinary returns binary representation of change").Line()
+	code.Func().Add(recv()).Id("MarshalBinary").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Return().Id("entity").Dot("AppendBinary").Call(jen.Nil()),
+	).Line()
+	code.Comment("UnmarshalBinary restores change from binary representation").Line()
+	code.Func().Add(recv()).Id("UnmarshalBinary").Params(jen.Id("data").Index().Byte()).Error().BlockFunc(func(decodeFunc *jen.Group) {
+		decodeFunc.Id("decoder").Op(":=").Op("&").Id(decoderType(proj)).Values(jen.Id("data").Op(":").Id("data"))
+		generateDecodeValue(decodeFunc, proj, jen.Id("entity").Dot(model.Indexed), keyType, 0)
+		decodeFunc.Id("entity").Dot("Action").Op("=").Id(proj.Name + "Action").Call(jen.Id("decoder").Dot("varint").Call())
+		decodeFunc.Id("entity").Dot("Item").Dot("decodeBinary").Call(jen.Id("decoder"))
+		decodeFunc.Return().Id("decoder").Dot("finish").Call()
+	}).Line()
+	return code
+}
@@ -0,0 +1,335 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+func hasUnique(proj *memdata.Project) bool {
+	for _, model := range proj.Models {
+		if len(model.Unique) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isIntegerType(typeName string) bool {
+	return memdata.IsNumType(typeName) && typeName != "float32" && typeName != "float64"
+}
+
+// generateErrors defines typed errors returned by writers
+func generateErrors(proj *memdata.Project) jen.Code {
+	code := jen.Comment("ErrUniqueViolation returned when written item has same value of unique field as another item").Line()
+	code.Type().Id("ErrUniqueViolation").Struct(
+		jen.Id("Model").String(),
+		jen.Id("Field").String(),
+	).Line()
+	code.Func().Params(jen.Id("err").Op("*").Id("ErrUniqueViolation")).Id("Error").Params().String().BlockFunc(func(errFunc *jen.Group) {
+		errFunc.Return().Lit("unique constraint violation: ").Op("+").Id("err").Dot("Model").Op("+").Lit(".").Op("+").Id("err").Dot("Field")
+	}).Line()
+	code.Add(generateReferenceErrors())
+	code.Comment("ErrConflict returned when updated item has different version than stored item").Line()
+	code.Type().Id("ErrConflict").Struct(
+		jen.Id("Model").String(),
+	).Line()
+	code.Func().Params(jen.Id("err").Op("*").Id("ErrConflict")).Id("Error").Params().String().BlockFunc(func(errFunc *jen.Group) {
+		errFunc.Return().Lit("version conflict: ").Op("+").Id("err").Dot("Model")
+	}).Line()
+	code.Comment("ErrValidation returned when field of written item violates validation rule").Line()
+	code.Type().Id("ErrValidation").Struct(
+		jen.Id("Model").String(),
+		jen.Id("Field").String(),
+		jen.Id("Rule").String(),
+	).Line()
+	code.Func().Params(jen.Id("err").Op("*").Id("ErrValidation")).Id("Error").Params().String().BlockFunc(func(errFunc *jen.Group) {
+		errFunc.Return().Lit("invalid ").Op("+").Id("err").Dot("Model").Op("+").Lit(".").Op("+").Id("err").Dot("Field").Op("+").Lit(": ").Op("+").Id("err").Dot("Rule")
+	}).Line()
+	return code
+}
+
+// generateVersionCheck returns an error from the enclosing writer if version of the item differs from version of the
+// stored item (with pending changes in transactional mode)
+func generateVersionCheck(group *jen.Group, model *memdata.Model) {
+	if model.Version == "" {
+		return
+	}
+	group.If(
+		jen.Id("current").Op(":=").Id("project").Dot("get"+model.Name).Call(jen.Id("item").Dot(model.Indexed)),
+		jen.Id("current").Op("!=").Nil().Op("&&").Id("current").Dot(model.Version).Op("!=").Id("item").Dot(model.Version),
+	).Block(
+		jen.Return(jen.Nil(), jen.Op("&").Id("ErrConflict").Values(jen.Id("Model").Op(":").Lit(model.Name))),
+	)
+}
+
+// generateUniqueCheck returns an error from the enclosing writer if the item (variable with pointer) violates unique
+// constraints
+func generateUniqueCheck(group *jen.Group, model *memdata.Model, item string) {
+	if len(model.Unique) == 0 {
+		return
+	}
+	group.If(jen.Err().Op(":=").Id("project").Dot("check"+model.Name+"Unique").Call(jen.Id(item)), jen.Err().Op("!=").Nil()).Block(
+		jen.Return(jen.Nil(), jen.Err()),
+	)
+}
+
+// generateUniqueChecks defines check functions of unique constraints for each model
+func generateUniqueChecks(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		if len(model.Unique) == 0 {
+			continue
+		}
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("check" + model.Name + "Unique").Params(jen.Id("item").Op("*").Id(model.Name)).Error().BlockFunc(func(checkFunc *jen.Group) {
+			for _, field := range model.Unique {
+				checkFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot("find" + model.Name + "By" + field).Call(jen.Id("item").Dot(field))).BlockFunc(func(iter *jen.Group) {
+					iter.If(jen.Id("key").Op("!=").Id("item").Dot(model.Indexed)).Block(
+						jen.Return().Op("&").Id("ErrUniqueViolation").Values(jen.Id("Model").Op(":").Lit(model.Name), jen.Id("Field").Op(":").Lit(field)),
+					)
+				})
+			}
+			checkFunc.Return().Nil()
+		}).Line()
+	}
+	return code
+}
+
+const (
+	ruleRestrict = "restrict"
+	ruleCascade  = "cascade"
+	ruleSetNull  = "set_null"
+	ruleIgnore   = "ignore"
+)
+
+// reference describes link from field of model to another model
+type reference struct {
+	Model *memdata.Model
+	Field string
+	Index modelIndex // index of model by keys of referenced model
+	Rule  string     // on delete rule
+}
+
+func referenceRule(model *memdata.Model, field string) string {
+	if rule, ok := model.OnDelete[field]; ok {
+		return rule
+	}
+	return ruleRestrict
+}
+
+// modelReferences lists all enforced links from the model to another models
+func modelReferences(model *memdata.Model) []reference {
+	var refs []reference
+	for _, index := range modelIndexes(model) {
+		if index.Target == nil {
+			continue
+		}
+		rule := referenceRule(model, index.Name)
+		if rule == ruleIgnore {
+			continue
+		}
+		refs = append(refs, reference{Model: model, Field: index.Name, Index: index, Rule: rule})
+	}
+	return refs
+}
+
+// modelReferrers lists all enforced links from another models to the model
+func modelReferrers(model *memdata.Model) []reference {
+	var refs []reference
+	for _, referrer := range model.Project.Models {
+		for _, ref := range modelReferences(referrer) {
+			if ref.Index.Target == model {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// removeChecks finds models which removal could be restricted directly or by cascade removal of referrers
+func removeChecks(proj *memdata.Project) map[*memdata.Model]bool {
+	checks := make(map[*memdata.Model]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, model := range proj.Models {
+			if checks[model] {
+				continue
+			}
+			for _, ref := range modelReferrers(model) {
+				if ref.Rule == ruleRestrict || (ref.Rule == ruleCascade && checks[ref.Model]) {
+					checks[model] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return checks
+}
+
+func zeroValue(typeName string) jen.Code {
+	switch {
+	case memdata.IsNumType(typeName):
+		return jen.Lit(0)
+	case typeName == "string":
+		return jen.Lit("")
+	case typeName == "bool":
+		return jen.False()
+	}
+	return jen.Op("*").New(jen.Id(typeName))
+}
+
+// generateReferenceErrors defines typed errors of referential integrity violations
+func generateReferenceErrors() jen.Code {
+	code := jen.Line().Comment("ErrMissingReference returned when written item refers to not existent item").Line()
+	code.Type().Id("ErrMissingReference").Struct(
+		jen.Id("Model").String(),
+		jen.Id("Field").String(),
+	).Line()
+	code.Func().Params(jen.Id("err").Op("*").Id("ErrMissingReference")).Id("Error").Params().String().BlockFunc(func(errFunc *jen.Group) {
+		errFunc.Return().Lit("missing reference: ").Op("+").Id("err").Dot("Model").Op("+").Lit(".").Op("+").Id("err").Dot("Field")
+	}).Line()
+	code.Comment("ErrRestrictViolation returned when removed item is referenced by another item with restrict rule").Line()
+	code.Type().Id("ErrRestrictViolation").Struct(
+		jen.Id("Model").String(),
+		jen.Id("Field").String(),
+	).Line()
+	code.Func().Params(jen.Id("err").Op("*").Id("ErrRestrictViolation")).Id("Error").Params().String().BlockFunc(func(errFunc *jen.Group) {
+		errFunc.Return().Lit("item is referenced by ").Op("+").Id("err").Dot("Model").Op("+").Lit(".").Op("+").Id("err").Dot("Field")
+	}).Line()
+	return code
+}
+
+// generateReferencesCheck returns an error from the enclosing writer if the item (variable with pointer) refers to not
+// existent items
+func generateReferencesCheck(group *jen.Group, model *memdata.Model, item string) {
+	if len(modelReferences(model)) == 0 {
+		return
+	}
+	group.If(jen.Err().Op(":=").Id("project").Dot("check"+model.Name+"References").Call(jen.Id(item)), jen.Err().Op("!=").Nil()).Block(
+		jen.Return(jen.Nil(), jen.Err()),
+	)
+}
+
+// generateReferences defines access to items (with pending changes), checks of references and removal with
+// following on-delete rules
+func generateReferences(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	checks := removeChecks(proj)
+	removalType := memdata.ToLowerCamel(proj.Name) + "Removal"
+	// visited items during checks of removal
+	code.Type().Id(removalType).StructFunc(func(st *jen.Group) {
+		for _, model := range proj.Models {
+			if checks[model] {
+				st.Id(model.Name).Map(jen.Id(model.FieldType(model.Indexed))).Bool()
+			}
+		}
+	}).Line()
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		// get item (with pending changes)
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("get" + model.Name).Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
+			if !proj.Transactional {
+				getFunc.Return().Id("project").Dot("index" + model.Name + "By" + model.Indexed).Dot("Get" + model.Name).Call(jen.Id(keyName))
+				return
+			}
+			getFunc.If(jen.List(jen.Id("entity"), jen.Id("changed")).Op(":=").Id("project").Dot("_pending"+model.Name).Index(jen.Id(keyName)), jen.Id("changed")).BlockFunc(func(pending *jen.Group) {
+				pending.If(jen.Id("entity").Dot("Action").Op("==").Id(proj.Name + "ActionDelete")).Block(jen.Return(jen.Nil()))
+				pending.Return(jen.Op("&").Id("entity").Dot("Item"))
+			})
+			getFunc.Return().Id("project").Dot("storage").Dot("Get" + model.Name).Call(jen.Id(keyName))
+		}).Line()
+		// check that referenced items exist
+		if refs := modelReferences(model); len(refs) > 0 {
+			code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("check" + model.Name + "References").Params(jen.Id("item").Op("*").Id(model.Name)).Error().BlockFunc(func(checkFunc *jen.Group) {
+				for _, ref := range refs {
+					target := ref.Index.Target
+					missing := jen.Return().Op("&").Id("ErrMissingReference").Values(jen.Id("Model").Op(":").Lit(model.Name), jen.Id("Field").Op(":").Lit(ref.Field))
+					if ref.Index.Many {
+						checkFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("item").Dot(ref.Index.Field)).Block(
+							jen.If(jen.Id("project").Dot("get" + target.Name).Call(jen.Id("key")).Op("==").Nil()).Block(missing),
+						)
+					} else {
+						checkFunc.If(jen.Id("item").Dot(ref.Index.Field).Op("!=").Add(zeroValue(ref.Index.Type)).Op("&&").Id("project").Dot("get" + target.Name).Call(jen.Id("item").Dot(ref.Index.Field)).Op("==").Nil()).Block(missing)
+					}
+				}
+				checkFunc.Return().Nil()
+			}).Line()
+		}
+		referrers := modelReferrers(model)
+		// check that item could be removed
+		if checks[model] {
+			code.Func().Parens(jen.Id("project").Op("*").Id("impl"+proj.Name)).Id("checkRemove"+model.Name).Params(jen.Id(keyName).Add(keyType), jen.Id("removal").Op("*").Id(removalType)).Error().BlockFunc(func(checkFunc *jen.Group) {
+				checkFunc.If(jen.Id("removal").Dot(model.Name).Op("==").Nil()).Block(
+					jen.Id("removal").Dot(model.Name).Op("=").Make(jen.Map(keyType).Bool()),
+				)
+				checkFunc.If(jen.Id("removal").Dot(model.Name).Index(jen.Id(keyName))).Block(jen.Return().Nil())
+				checkFunc.Id("removal").Dot(model.Name).Index(jen.Id(keyName)).Op("=").True()
+				for _, ref := range referrers {
+					find := jen.Id("project").Dot("find" + ref.Model.Name + "By" + ref.Field).Call(jen.Id(keyName))
+					switch {
+					case ref.Rule == ruleRestrict:
+						checkFunc.If(jen.Len(find).Op(">").Lit(0)).Block(
+							jen.Return().Op("&").Id("ErrRestrictViolation").Values(jen.Id("Model").Op(":").Lit(ref.Model.Name), jen.Id("Field").Op(":").Lit(ref.Field)),
+						)
+					case ref.Rule == ruleCascade && checks[ref.Model]:
+						checkFunc.For(jen.List(jen.Id("_"), jen.Id("referrer")).Op(":=").Range().Add(find)).Block(
+							jen.If(jen.Err().Op(":=").Id("project").Dot("checkRemove"+ref.Model.Name).Call(jen.Id("referrer"), jen.Id("removal")), jen.Err().Op("!=").Nil()).Block(
+								jen.Return().Err(),
+							),
+						)
+					}
+				}
+				checkFunc.Return().Nil()
+			}).Line()
+		}
+		// remove item and follow on-delete rules of referrers. Writes of storages could fail in errors mode: removal stops
+		// on the first error.
+		removeParams := []jen.Code{jen.Id(keyName).Add(keyType)}
+		var removeResult jen.Code = jen.Null()
+		if writeErrors(proj) {
+			removeParams = withContext(proj, removeParams...)
+			removeResult = jen.Error()
+		}
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("remove" + model.Name).Params(removeParams...).Add(removeResult).BlockFunc(func(removeFunc *jen.Group) {
+			generateWrite(removeFunc, model, "Delete")
+			for _, ref := range referrers {
+				find := jen.Id("project").Dot("find" + ref.Model.Name + "By" + ref.Field).Call(jen.Id(keyName))
+				switch ref.Rule {
+				case ruleCascade:
+					removeFunc.For(jen.List(jen.Id("_"), jen.Id("referrer")).Op(":=").Range().Add(find)).BlockFunc(func(iter *jen.Group) {
+						if writeErrors(proj) {
+							generateStorageWrite(iter, proj, jen.Id("project").Dot("remove"+ref.Model.Name).Call(jen.Id("ctx"), jen.Id("referrer")))
+							return
+						}
+						iter.Id("project").Dot("remove" + ref.Model.Name).Call(jen.Id("referrer"))
+					})
+				case ruleSetNull:
+					removeFunc.For(jen.List(jen.Id("_"), jen.Id("referrer")).Op(":=").Range().Add(find)).BlockFunc(func(iter *jen.Group) {
+						iter.Id("changed").Op(":=").Op("*").Id("project").Dot("get" + ref.Model.Name).Call(jen.Id("referrer"))
+						iter.Id("item").Op(":=").Op("&").Id("changed")
+						if ref.Index.Many {
+							iter.Id("keys").Op(":=").Make(jen.Index().Id(ref.Index.Type), jen.Lit(0), jen.Len(jen.Id("item").Dot(ref.Index.Field)))
+							iter.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("item").Dot(ref.Index.Field)).Block(
+								jen.If(jen.Id("key").Op("!=").Id(keyName)).Block(
+									jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("key")),
+								),
+							)
+							iter.Id("item").Dot(ref.Index.Field).Op("=").Id("keys")
+						} else {
+							iter.Id("item").Dot(ref.Index.Field).Op("=").Add(zeroValue(ref.Index.Type))
+						}
+						if ref.Model.Version != "" {
+							iter.Id("item").Dot(ref.Model.Version).Op("++")
+						}
+						generateWrite(iter, ref.Model, "Update")
+					})
+				}
+			}
+			if writeErrors(proj) {
+				removeFunc.Return().Nil()
+			}
+		}).Line()
+	}
+	return code
+}
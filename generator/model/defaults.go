@@ -0,0 +1,136 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"strconv"
+	"strings"
+)
+
+const (
+	autoCreatedAt = "created_at"
+	autoUpdatedAt = "updated_at"
+)
+
+func hasTimestamps(proj *memdata.Project) bool {
+	for _, model := range proj.Models {
+		if len(model.Auto) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isTimeType checks that type of field is time.Time (by any alias of imported time package)
+func isTimeType(proj *memdata.Project, typeName string) bool {
+	if !strings.HasSuffix(typeName, ".Time") {
+		return false
+	}
+	return proj.Imports[strings.TrimSuffix(typeName, ".Time")] == "time"
+}
+
+// checkDefaults checks default values and timestamps of the model
+func checkDefaults(model *memdata.Model) {
+	for _, field := range sortedKeys(model.Defaults) {
+		defaultLiteral(model, field)
+		if model.FieldType(field) == "bool" && model.Defaults[field] != "false" {
+			// false could not be inserted: it is zero value and replaced by default
+			panic("default value of bool field " + model.Name + "." + field + " requires pointer (*bool) field")
+		}
+	}
+	for _, field := range sortedKeys(model.Auto) {
+		switch model.Auto[field] {
+		case autoCreatedAt, autoUpdatedAt:
+		default:
+			panic("unknown auto value " + model.Auto[field] + " of " + model.Name + "." + field)
+		}
+		if !isTimeType(model.Project, model.FieldType(field)) {
+			panic("auto value of " + model.Name + "." + field + " requires time.Time field (with import of time package)")
+		}
+	}
+}
+
+// defaultLiteral converts default value of YAML to literal of field type
+func defaultLiteral(model *memdata.Model, field string) jen.Code {
+	value := model.Defaults[field]
+	path := model.Name + "." + field
+	fieldType := strings.TrimPrefix(model.FieldType(field), "*")
+	switch {
+	case fieldType == "string":
+		return jen.Lit(value)
+	case fieldType == "bool":
+		flag, err := strconv.ParseBool(value)
+		if err != nil {
+			panic("invalid default value " + value + " of " + path)
+		}
+		return jen.Lit(flag)
+	case memdata.IsNumType(fieldType):
+		return numberLiteral(path, fieldType, value)
+	}
+	panic("default value of " + path + " requires string, bool or number field (or pointer to it)")
+}
+
+// generateDefaults sets default values of zero fields of inserted item (variable with pointer). Pointer fields are
+// set to new value if nil, so zero value of pointer field could be inserted.
+func generateDefaults(group *jen.Group, model *memdata.Model, item string) {
+	for _, field := range sortedKeys(model.Defaults) {
+		fieldType := model.FieldType(field)
+		if strings.HasPrefix(fieldType, "*") {
+			group.If(jen.Id(item).Dot(field).Op("==").Nil()).Block(
+				jen.Id(item).Dot(field).Op("=").New(jen.Id(fieldType[1:])),
+				jen.Op("*").Id(item).Dot(field).Op("=").Add(defaultLiteral(model, field)),
+			)
+			continue
+		}
+		empty := jen.Id(item).Dot(field).Op("==").Add(zeroValue(fieldType))
+		if fieldType == "bool" {
+			empty = jen.Op("!").Id(item).Dot(field)
+		}
+		group.If(empty).Block(
+			jen.Id(item).Dot(field).Op("=").Add(defaultLiteral(model, field)),
+		)
+	}
+}
+
+// generateTimestamps sets automatic timestamps of written item (variable with pointer) by project clock. Updated item
+// keeps creation time of the stored item.
+func generateTimestamps(group *jen.Group, model *memdata.Model, action string, item string) {
+	var now, created []string
+	for _, field := range sortedKeys(model.Auto) {
+		if action == "Update" && model.Auto[field] == autoCreatedAt {
+			created = append(created, field)
+		} else {
+			now = append(now, field)
+		}
+	}
+	if len(now) > 0 {
+		group.Id("now").Op(":=").Id("project").Dot("_clock").Call()
+	}
+	for _, field := range now {
+		group.Id(item).Dot(field).Op("=").Id("now")
+	}
+	if len(created) == 0 {
+		return
+	}
+	group.If(jen.Id("current").Op(":=").Id("project").Dot("get"+model.Name).Call(jen.Id(item).Dot(model.Indexed)), jen.Id("current").Op("!=").Nil()).BlockFunc(func(keep *jen.Group) {
+		for _, field := range created {
+			keep.Id(item).Dot(field).Op("=").Id("current").Dot(field)
+		}
+	})
+}
+
+// generateClock defines replacement of the project clock
+func generateClock(proj *memdata.Project) jen.Code {
+	code := jen.Comment("SetClock replaces source of current time for automatic timestamps (time.Now by default)").Line()
+	code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("SetClock").Params(jen.Id("clock").Func().Params().Qual("time", "Time")).BlockFunc(func(setFunc *jen.Group) {
+		if proj.Transactional {
+			setFunc.Id("project").Dot("_tx").Dot("Lock").Call()
+			setFunc.Defer().Id("project").Dot("_tx").Dot("Unlock").Call()
+		} else if proj.Synchronized {
+			setFunc.Id("project").Dot("_lock").Dot("Lock").Call()
+			setFunc.Defer().Id("project").Dot("_lock").Dot("Unlock").Call()
+		}
+		setFunc.Id("project").Dot("_clock").Op("=").Id("clock")
+	}).Line()
+	return code
+}
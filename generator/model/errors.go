@@ -0,0 +1,65 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// Errors mode: writes of storages (Put, Update, Delete and Apply), writer and Commit take context.Context and return
+// errors of storage. Reads of storages keep their signatures.
+
+// withContext prepends context to parameters of storage write, writer or Commit in errors mode
+func withContext(proj *memdata.Project, params ...jen.Code) []jen.Code {
+	if !proj.Errors {
+		return params
+	}
+	return append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, params...)
+}
+
+// contextArg prepends context to arguments of call with parameters by withContext
+func contextArg(proj *memdata.Project, args ...jen.Code) []jen.Code {
+	if !proj.Errors {
+		return args
+	}
+	return append([]jen.Code{jen.Id("ctx")}, args...)
+}
+
+// storageError is result of storage write in errors mode
+func storageError(proj *memdata.Project) jen.Code {
+	if proj.Errors {
+		return jen.Error()
+	}
+	return jen.Null()
+}
+
+// writeErrors reports that writes of the project are passed to storages and could fail (non-transactional errors
+// mode). Writes of transactional project are kept in the log till Commit.
+func writeErrors(proj *memdata.Project) bool {
+	return proj.Errors && !proj.Transactional
+}
+
+// commitError reports that Commit returns error: failed write-ahead log or storage
+func commitError(proj *memdata.Project) bool {
+	return proj.WAL || proj.Errors
+}
+
+// generateStorageWrite calls write of storage: in errors mode error of storage is returned after fail values
+func generateStorageWrite(group *jen.Group, proj *memdata.Project, call *jen.Statement, fail ...jen.Code) {
+	generateUndoableStorageWrite(group, proj, call, nil, fail...)
+}
+
+// generateUndoableStorageWrite is generateStorageWrite with statements of undo executed before return of error
+func generateUndoableStorageWrite(group *jen.Group, proj *memdata.Project, call *jen.Statement, undo []jen.Code, fail ...jen.Code) {
+	if !proj.Errors {
+		group.Add(call)
+		return
+	}
+	group.If(jen.Err().Op(":=").Add(call), jen.Err().Op("!=").Nil()).Block(append(undo, jen.Return(append(fail, jen.Err())...))...)
+}
+
+// generateStorageResult ends write of storage: no error of in-memory storage in errors mode
+func generateStorageResult(group *jen.Group, proj *memdata.Project) {
+	if proj.Errors {
+		group.Return().Nil()
+	}
+}
@@ -0,0 +1,290 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+const defaultEventsBuffer = 64
+
+func eventType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Event"
+}
+
+func subscribersType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Subscribers"
+}
+
+func queueType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "EventQueue"
+}
+
+func handlerType(model *memdata.Model) *jen.Statement {
+	return jen.Func().Params(jen.List(jen.Id("old"), jen.Id("new")).Op("*").Id(model.Name), jen.Id("action").Id(model.Project.Name+"Action"))
+}
+
+// generateEventsInterface adds subscriptions to the project main interface
+func generateEventsInterface(iface *jen.Group, proj *memdata.Project) {
+	for _, model := range proj.Models {
+		iface.Id("Subscribe" + model.Name).Params(jen.Id("handler").Add(handlerType(model))).Params(jen.Id("unsubscribe").Func().Params())
+	}
+	iface.Id("Watch").Params().Op("<-").Chan().Id(proj.Name + "LogEntity")
+	iface.Id("Unwatch").Params(jen.Id("watcher").Op("<-").Chan().Id(proj.Name + "LogEntity"))
+}
+
+// generateWriteLock locks the project for writing till the end of writer function. Recorded events are published
+// after unlock, so handlers could use the project.
+func generateWriteLock(group *jen.Group, proj *memdata.Project) {
+	if proj.Events && !proj.Transactional {
+		group.Defer().Id("project").Dot("flushEvents").Call()
+	}
+	if proj.Synchronized {
+		group.Id("project").Dot("_lock").Dot("Lock").Call()
+		group.Defer().Id("project").Dot("_lock").Dot("Unlock").Call()
+	}
+}
+
+// generatePreviousItem obtains previous state of changed item before write in non-transactional mode
+func generatePreviousItem(group *jen.Group, model *memdata.Model, action string) {
+	if action == "Insert" {
+		return
+	}
+	storage := jen.Id("project").Dot("index" + model.Name + "By" + model.Indexed)
+	group.Id("previous").Op(":=").Add(storage).Dot("Get" + model.Name).Call(eventKey(model, action))
+}
+
+// eventKey is key of changed item
+func eventKey(model *memdata.Model, action string) *jen.Statement {
+	if action == "Delete" {
+		return jen.Id(memdata.ToLowerCamel(model.Indexed))
+	}
+	return jen.Id("item").Dot(model.Indexed)
+}
+
+// generateRecordEvent records change of item in non-transactional mode after write: previous item should be obtained
+// before write by generatePreviousItem
+func generateRecordEvent(group *jen.Group, model *memdata.Model, action string) {
+	proj := model.Project
+	old := jen.Id("previous")
+	if action == "Insert" {
+		old = jen.Nil()
+	}
+	group.Id("project").Dot("record"+model.Name).Call(old, jen.Op("&").Id(model.Name+"LogEntity").ValuesFunc(func(modelLog *jen.Group) {
+		modelLog.Id(model.Indexed).Op(":").Add(eventKey(model, action))
+		if action != "Delete" {
+			modelLog.Id("Item").Op(":").Op("*").Id("item")
+		}
+		modelLog.Id("Action").Op(":").Id(proj.Name + "Action" + action)
+	}))
+}
+
+// generateEvents defines subscribers registry, collecting and publishing of events
+func generateEvents(proj *memdata.Project) jen.Code {
+	event := eventType(proj)
+	subscribers := subscribersType(proj)
+	entityType := jen.Id(proj.Name + "LogEntity")
+	buffer := proj.EventsBuffer
+	if buffer == 0 {
+		buffer = defaultEventsBuffer
+	}
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)) }
+	// event: change and previous state of item
+	code := jen.Type().Id(event).StructFunc(func(st *jen.Group) {
+		st.Id("entity").Add(entityType)
+		for _, model := range proj.Models {
+			st.Id("old" + model.Name).Op("*").Id(model.Name)
+		}
+	}).Line()
+	for _, model := range proj.Models {
+		code.Type().Id(memdata.ToLowerCamel(proj.Name)+model.Name+"Subscriber").Struct(
+			jen.Id("id").Int(),
+			jen.Id("handler").Add(handlerType(model)),
+		).Line()
+	}
+	code.Type().Id(subscribers).StructFunc(func(st *jen.Group) {
+		st.Id("lock").Qual("sync", "RWMutex")
+		st.Id("next").Int()
+		for _, model := range proj.Models {
+			st.Id(memdata.ToLowerCamel(model.Name)).Index().Id(memdata.ToLowerCamel(proj.Name) + model.Name + "Subscriber")
+		}
+		st.Id("watchers").Map(jen.Op("<-").Chan().Add(entityType)).Chan().Add(entityType)
+	}).Line()
+	// published events in order of writes: only one goroutine delivers events at a time
+	code.Type().Id(queueType(proj)).Struct(
+		jen.Id("lock").Qual("sync", "Mutex"),
+		jen.Id("events").Index().Id(event),
+		jen.Id("draining").Bool(),
+	).Line()
+	// subscribe and unsubscribe
+	for _, model := range proj.Models {
+		field := jen.Id("subscribers").Dot(memdata.ToLowerCamel(model.Name))
+		code.Comment("Subscribe" + model.Name + " registers handler of committed changes of " + model.Name + " (old is nil for inserted item, new is nil for removed)").Line()
+		code.Func().Add(recv()).Id("Subscribe" + model.Name).Params(jen.Id("handler").Add(handlerType(model))).Params(jen.Id("unsubscribe").Func().Params()).BlockFunc(func(subFunc *jen.Group) {
+			subFunc.Id("subscribers").Op(":=").Op("&").Id("project").Dot("_subscribers")
+			subFunc.Id("subscribers").Dot("lock").Dot("Lock").Call()
+			subFunc.Defer().Id("subscribers").Dot("lock").Dot("Unlock").Call()
+			subFunc.Id("subscribers").Dot("next").Op("++")
+			subFunc.Id("id").Op(":=").Id("subscribers").Dot("next")
+			subFunc.Add(field.Clone()).Op("=").Append(field.Clone(), jen.Id(memdata.ToLowerCamel(proj.Name)+model.Name+"Subscriber").Values(jen.Id("id"), jen.Id("handler")))
+			subFunc.Return().Func().Params().Block(
+				jen.Id("subscribers").Dot("lock").Dot("Lock").Call(),
+				jen.Defer().Id("subscribers").Dot("lock").Dot("Unlock").Call(),
+				jen.For(jen.List(jen.Id("i"), jen.Id("subscriber")).Op(":=").Range().Add(field.Clone())).Block(
+					jen.If(jen.Id("subscriber").Dot("id").Op("==").Id("id")).Block(
+						field.Clone().Op("=").Append(field.Clone().Index(jen.Empty(), jen.Id("i"), jen.Id("i")), field.Clone().Index(jen.Id("i").Op("+").Lit(1), jen.Empty()).Op("...")),
+						jen.Return(),
+					),
+				),
+			)
+		}).Line()
+	}
+	// watch and unwatch
+	code.Comment("Watch returns channel of committed changes. If buffer is full, the oldest change is dropped").Line()
+	code.Func().Add(recv()).Id("Watch").Params().Op("<-").Chan().Add(entityType).BlockFunc(func(watchFunc *jen.Group) {
+		watchFunc.Id("watcher").Op(":=").Make(jen.Chan().Add(entityType), jen.Lit(buffer))
+		watchFunc.Id("subscribers").Op(":=").Op("&").Id("project").Dot("_subscribers")
+		watchFunc.Id("subscribers").Dot("lock").Dot("Lock").Call()
+		watchFunc.Defer().Id("subscribers").Dot("lock").Dot("Unlock").Call()
+		watchFunc.If(jen.Id("subscribers").Dot("watchers").Op("==").Nil()).Block(
+			jen.Id("subscribers").Dot("watchers").Op("=").Make(jen.Map(jen.Op("<-").Chan().Add(entityType)).Chan().Add(entityType)),
+		)
+		watchFunc.Id("subscribers").Dot("watchers").Index(jen.Id("watcher")).Op("=").Id("watcher")
+		watchFunc.Return().Id("watcher")
+	}).Line()
+	code.Comment("Unwatch stops delivery of changes and closes the channel").Line()
+	code.Func().Add(recv()).Id("Unwatch").Params(jen.Id("watcher").Op("<-").Chan().Add(entityType)).BlockFunc(func(unwatchFunc *jen.Group) {
+		unwatchFunc.Id("subscribers").Op(":=").Op("&").Id("project").Dot("_subscribers")
+		unwatchFunc.Id("subscribers").Dot("lock").Dot("Lock").Call()
+		unwatchFunc.Defer().Id("subscribers").Dot("lock").Dot("Unlock").Call()
+		unwatchFunc.If(jen.List(jen.Id("channel"), jen.Id("ok")).Op(":=").Id("subscribers").Dot("watchers").Index(jen.Id("watcher")), jen.Id("ok")).Block(
+			jen.Delete(jen.Id("subscribers").Dot("watchers"), jen.Id("watcher")),
+			jen.Close(jen.Id("channel")),
+		)
+	}).Line()
+	if proj.Transactional {
+		// previous state of items from committed state and earlier changes of the batch
+		code.Func().Add(recv()).Id("collectEvents").Params(jen.Id("batch").Index().Add(entityType)).Index().Id(event).BlockFunc(func(collectFunc *jen.Group) {
+			collectFunc.Id("events").Op(":=").Make(jen.Index().Id(event), jen.Lit(0), jen.Len(jen.Id("batch")))
+			for _, model := range proj.Models {
+				collectFunc.Id("current" + model.Name).Op(":=").Make(jen.Map(jen.Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name))
+			}
+			collectFunc.For(jen.List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch")).BlockFunc(func(iter *jen.Group) {
+				iter.Id("event").Op(":=").Id(event).Values(jen.Id("entity").Op(":").Id("tx"))
+				for _, model := range proj.Models {
+					entity := jen.Id("tx").Dot(model.Name)
+					current := jen.Id("current" + model.Name)
+					iter.If(entity.Clone().Op("!=").Nil()).Block(
+						jen.List(jen.Id("old"), jen.Id("ok")).Op(":=").Add(current.Clone()).Index(entity.Clone().Dot(model.Indexed)),
+						jen.If(jen.Op("!").Id("ok")).Block(
+							jen.Id("old").Op("=").Id("project").Dot("storage").Dot("Get"+model.Name).Call(entity.Clone().Dot(model.Indexed)),
+						),
+						jen.Id("event").Dot("old"+model.Name).Op("=").Id("old"),
+						jen.If(entity.Clone().Dot("Action").Op("==").Id(proj.Name+"ActionDelete")).Block(
+							current.Clone().Index(entity.Clone().Dot(model.Indexed)).Op("=").Nil(),
+						).Else().Block(
+							current.Clone().Index(entity.Clone().Dot(model.Indexed)).Op("=").Op("&").Add(entity.Clone()).Dot("Item"),
+						),
+					)
+				}
+				iter.Id("events").Op("=").Append(jen.Id("events"), jen.Id("event"))
+			})
+			collectFunc.Return().Id("events")
+		}).Line()
+	} else {
+		// record change of item
+		for _, model := range proj.Models {
+			code.Func().Add(recv()).Id("record"+model.Name).Params(jen.Id("old").Op("*").Id(model.Name), jen.Id("entity").Op("*").Id(model.Name+"LogEntity")).Block(
+				jen.If(jen.Id("old").Op("==").Nil().Op("&&").Id("entity").Dot("Action").Op("==").Id(proj.Name+"ActionDelete")).Block(
+					jen.Comment("nothing removed"),
+					jen.Return(),
+				),
+				jen.Id("project").Dot("_events").Op("=").Append(jen.Id("project").Dot("_events"), jen.Id(event).Values(
+					jen.Id("entity").Op(":").Add(entityType).Values(jen.Id(model.Name).Op(":").Id("entity")),
+					jen.Id("old"+model.Name).Op(":").Id("old"),
+				)),
+			).Line()
+		}
+		// publish recorded events (called after unlock of writer in reverse order of defer)
+		code.Func().Add(recv()).Id("flushEvents").Params().BlockFunc(func(flushFunc *jen.Group) {
+			if proj.Synchronized {
+				flushFunc.Id("project").Dot("_lock").Dot("Lock").Call()
+			}
+			flushFunc.Id("project").Dot("enqueue").Call(jen.Id("project").Dot("_events"))
+			flushFunc.Id("project").Dot("_events").Op("=").Nil()
+			if proj.Synchronized {
+				flushFunc.Id("project").Dot("_lock").Dot("Unlock").Call()
+			}
+			flushFunc.Id("project").Dot("drain").Call()
+		}).Line()
+	}
+	// enqueue events while writes are still ordered by lock
+	code.Func().Add(recv()).Id("enqueue").Params(jen.Id("events").Index().Id(event)).BlockFunc(func(enqueueFunc *jen.Group) {
+		enqueueFunc.If(jen.Len(jen.Id("events")).Op("==").Lit(0)).Block(jen.Return())
+		enqueueFunc.Id("project").Dot("_queue").Dot("lock").Dot("Lock").Call()
+		enqueueFunc.Id("project").Dot("_queue").Dot("events").Op("=").Append(jen.Id("project").Dot("_queue").Dot("events"), jen.Id("events").Op("..."))
+		enqueueFunc.Id("project").Dot("_queue").Dot("lock").Dot("Unlock").Call()
+	}).Line()
+	// publish queued events after unlock. If events are published by other goroutine (or by handler which wrote to the
+	// project), they will be published by it after the current ones
+	code.Func().Add(recv()).Id("drain").Params().BlockFunc(func(drainFunc *jen.Group) {
+		drainFunc.Id("queue").Op(":=").Op("&").Id("project").Dot("_queue")
+		drainFunc.Id("queue").Dot("lock").Dot("Lock").Call()
+		drainFunc.If(jen.Id("queue").Dot("draining")).Block(
+			jen.Id("queue").Dot("lock").Dot("Unlock").Call(),
+			jen.Return(),
+		)
+		drainFunc.Id("queue").Dot("draining").Op("=").True()
+		drainFunc.For(jen.Len(jen.Id("queue").Dot("events")).Op(">").Lit(0)).Block(
+			jen.Id("events").Op(":=").Id("queue").Dot("events"),
+			jen.Id("queue").Dot("events").Op("=").Nil(),
+			jen.Id("queue").Dot("lock").Dot("Unlock").Call(),
+			jen.Id("project").Dot("publish").Call(jen.Id("events")),
+			jen.Id("queue").Dot("lock").Dot("Lock").Call(),
+		)
+		drainFunc.Id("queue").Dot("draining").Op("=").False()
+		drainFunc.Id("queue").Dot("lock").Dot("Unlock").Call()
+	}).Line()
+	// publish to handlers (without lock, so handlers could unsubscribe) and watchers
+	code.Func().Add(recv()).Id("publish").Params(jen.Id("events").Index().Id(event)).BlockFunc(func(publishFunc *jen.Group) {
+		publishFunc.Id("subscribers").Op(":=").Op("&").Id("project").Dot("_subscribers")
+		publishFunc.Id("subscribers").Dot("lock").Dot("RLock").Call()
+		for _, model := range proj.Models {
+			field := memdata.ToLowerCamel(model.Name)
+			publishFunc.Id(field + "Subscribers").Op(":=").Id("subscribers").Dot(field)
+		}
+		publishFunc.For(jen.List(jen.Id("_"), jen.Id("event")).Op(":=").Range().Id("events")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("watcher")).Op(":=").Range().Id("subscribers").Dot("watchers")).Block(
+				jen.Select().Block(
+					jen.Case(jen.Id("watcher").Op("<-").Id("event").Dot("entity")),
+					jen.Default().Block(
+						jen.Comment("buffer is full: drop the oldest change"),
+						jen.Select().Block(
+							jen.Case(jen.Op("<-").Id("watcher")),
+							jen.Default(),
+						),
+						jen.Select().Block(
+							jen.Case(jen.Id("watcher").Op("<-").Id("event").Dot("entity")),
+							jen.Default(),
+						),
+					),
+				),
+			),
+		)
+		publishFunc.Id("subscribers").Dot("lock").Dot("RUnlock").Call()
+		publishFunc.For(jen.List(jen.Id("_"), jen.Id("event")).Op(":=").Range().Id("events")).BlockFunc(func(iter *jen.Group) {
+			for _, model := range proj.Models {
+				entity := jen.Id("event").Dot("entity").Dot(model.Name)
+				iter.If(jen.Id("entity").Op(":=").Add(entity), jen.Id("entity").Op("!=").Nil()).Block(
+					jen.Var().Id("item").Op("*").Id(model.Name),
+					jen.If(jen.Id("entity").Dot("Action").Op("!=").Id(proj.Name+"ActionDelete")).Block(
+						jen.Id("item").Op("=").Op("&").Id("entity").Dot("Item"),
+					),
+					jen.For(jen.List(jen.Id("_"), jen.Id("subscriber")).Op(":=").Range().Id(memdata.ToLowerCamel(model.Name)+"Subscribers")).Block(
+						jen.Id("subscriber").Dot("handler").Call(jen.Id("event").Dot("old"+model.Name), jen.Id("item"), jen.Id("entity").Dot("Action")),
+					),
+				)
+			}
+		})
+	}).Line()
+	return code
+}
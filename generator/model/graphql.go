@@ -0,0 +1,430 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"strings"
+)
+
+// GraphQL schema of the project has type of each model with fields (keys of items and links are IDs), links and back
+// references, and Query with item by key and list of items of each model. Resolvers are plain methods named as fields.
+
+// graphqlName converts name of Go field to name of GraphQL field (ex: HTTPPort to httpPort)
+func graphqlName(name string) string {
+	upper := 0
+	for upper < len(name) && isUpper(name[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(name) {
+		upper-- // the last upper letter starts next word
+	}
+	if upper == 0 {
+		upper = 1
+	}
+	return strings.ToLower(name[:upper]) + name[upper:]
+}
+
+func rootResolverType(proj *memdata.Project) string {
+	return proj.Name + "Resolver"
+}
+
+func resolverType(model *memdata.Model) string {
+	return model.Name + "Resolver"
+}
+
+func batchType(model *memdata.Model) string {
+	return memdata.ToLowerCamel(model.Project.Name) + model.Name + "Batch"
+}
+
+// graphqlScalar returns GraphQL type of single value or empty string if type is not supported
+func graphqlScalar(proj *memdata.Project, typeName string) string {
+	switch {
+	case typeName == "string" || isTimeType(proj, typeName):
+		return "String"
+	case typeName == "bool":
+		return "Boolean"
+	case typeName == "int8" || typeName == "int16" || typeName == "int32" || typeName == "rune" ||
+		typeName == "uint8" || typeName == "byte" || typeName == "uint16":
+		return "Int"
+	case memdata.IsNumType(typeName):
+		// GraphQL Int is 32-bit: wider integers are exact floats up to 2^53
+		return "Float"
+	}
+	return ""
+}
+
+// graphqlType returns GraphQL type of field or empty string if type is not supported
+func graphqlType(proj *memdata.Project, typeName string) string {
+	switch {
+	case strings.HasPrefix(typeName, "[]") && typeName != "[]byte":
+		if elem := graphqlScalar(proj, typeName[2:]); elem != "" {
+			return "[" + elem + "!]!"
+		}
+		return ""
+	case strings.HasPrefix(typeName, "*"):
+		return graphqlScalar(proj, typeName[1:])
+	}
+	if scalar := graphqlScalar(proj, typeName); scalar != "" {
+		return scalar + "!"
+	}
+	return ""
+}
+
+// graphqlGoType returns Go type of resolved GraphQL scalar
+func graphqlGoType(scalar string) string {
+	switch scalar {
+	case "String", "ID":
+		return "string"
+	case "Boolean":
+		return "bool"
+	case "Int":
+		return "int32"
+	}
+	return "float64"
+}
+
+type graphqlField struct {
+	Name   string // name of field in schema
+	Method string // name of resolver method
+	Type   string // type of field in schema
+	Field  string // name of struct field of model
+	GoType string // type of struct field
+	Link   *modelLink
+	Back   *modelIndex
+}
+
+// graphqlFields lists fields of type of model: key, fields, links and back references
+func graphqlFields(model *memdata.Model) []graphqlField {
+	var fields []graphqlField
+	links := make(map[string]modelLink)
+	for _, link := range modelLinks(model) {
+		links[link.Field] = link
+	}
+	for _, field := range binaryFields(model) {
+		fieldType := graphqlType(model.Project, field.Type)
+		if link, ok := links[field.Name]; ok && link.Many {
+			fieldType = "[ID!]!"
+		} else if ok || field.Name == model.Indexed {
+			fieldType = "ID!"
+		}
+		fields = append(fields, graphqlField{Name: graphqlName(field.Name), Method: field.Name, Type: fieldType, Field: field.Name, GoType: field.Type})
+	}
+	for _, link := range modelLinks(model) {
+		link := link
+		fieldType := link.Target.Name
+		if link.Many {
+			fieldType = "[" + link.Target.Name + "!]!"
+		}
+		fields = append(fields, graphqlField{Name: graphqlName(link.Name), Method: link.Name, Type: fieldType, Link: &link})
+	}
+	for _, index := range modelBackReferences(model) {
+		index := index
+		method := index.Model.Name + "sBy" + index.Name
+		fields = append(fields, graphqlField{Name: graphqlName(method), Method: method, Type: "[" + index.Model.Name + "!]!", Back: &index})
+	}
+	return fields
+}
+
+// checkGraphQL checks that keys and fields of models could be represented in GraphQL
+func checkGraphQL(proj *memdata.Project) {
+	for _, model := range proj.Models {
+		if !isOrderedType(model.FieldType(model.Indexed)) {
+			panic("graphql requires number or string key of " + model.Name)
+		}
+		seen := make(map[string]string)
+		for _, field := range graphqlFields(model) {
+			if field.Type == "" {
+				panic("graphql requires number, bool, string, time.Time, pointer or slice field " + model.Name + "." + field.Field)
+			}
+			if other, ok := seen[field.Name]; ok {
+				panic("graphql names of " + model.Name + "." + field.Method + " and " + model.Name + "." + other + " are the same")
+			}
+			seen[field.Name] = field.Method
+		}
+	}
+}
+
+// GenerateGraphQL renders GraphQL schema (SDL) of the project
+func GenerateGraphQL(proj *memdata.Project) string {
+	prepareProject(proj)
+	checkGraphQL(proj)
+	out := &strings.Builder{}
+	out.WriteString("schema {\n  query: Query\n}\n\n")
+	out.WriteString("type Query {\n")
+	for _, model := range proj.Models {
+		name := graphqlName(model.Name)
+		out.WriteString("  " + name + "(" + graphqlName(model.Indexed) + ": ID!): " + model.Name + "\n")
+		out.WriteString("  " + name + "s: [" + model.Name + "!]!\n")
+	}
+	out.WriteString("}\n")
+	for _, model := range proj.Models {
+		out.WriteString("\ntype " + model.Name + " {\n")
+		for _, field := range graphqlFields(model) {
+			out.WriteString("  " + field.Name + ": " + field.Type + "\n")
+		}
+		out.WriteString("}\n")
+	}
+	return out.String()
+}
+
+// formatID converts key value to GraphQL ID
+func formatID(value jen.Code, keyType string) jen.Code {
+	switch {
+	case keyType == "string":
+		return value
+	case keyType == "float32" || keyType == "float64":
+		return jen.Qual("strconv", "FormatFloat").Call(jen.Float64().Call(value), jen.LitRune('g'), jen.Lit(-1), jen.Lit(64))
+	case isSignedType(keyType):
+		return jen.Qual("strconv", "FormatInt").Call(jen.Int64().Call(value), jen.Lit(10))
+	}
+	return jen.Qual("strconv", "FormatUint").Call(jen.Uint64().Call(value), jen.Lit(10))
+}
+
+// resolvedValue converts single value of field to value of GraphQL scalar
+func resolvedValue(proj *memdata.Project, value jen.Code, typeName string) jen.Code {
+	if isTimeType(proj, typeName) {
+		return jen.Add(value).Dot("Format").Call(jen.Qual("time", "RFC3339Nano"))
+	}
+	goType := graphqlGoType(graphqlScalar(proj, typeName))
+	if goType == typeName {
+		return value
+	}
+	return jen.Id(goType).Call(value)
+}
+
+// generateResolveField returns value of field of model
+func generateResolveField(group *jen.Group, proj *memdata.Project, field graphqlField, value *jen.Statement) {
+	switch {
+	case field.Type == "ID!":
+		group.Return(formatID(value, field.GoType))
+	case field.Type == "[ID!]!":
+		group.Id("ids").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(value.Clone()))
+		group.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Add(value.Clone())).Block(
+			jen.Id("ids").Op("=").Append(jen.Id("ids"), formatID(jen.Id("key"), field.GoType[2:])),
+		)
+		group.Return(jen.Id("ids"))
+	case strings.HasPrefix(field.GoType, "[]"):
+		elem := field.GoType[2:]
+		group.Id("values").Op(":=").Make(jen.Index().Id(graphqlGoType(graphqlScalar(proj, elem))), jen.Lit(0), jen.Len(value.Clone()))
+		group.For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Add(value.Clone())).Block(
+			jen.Id("values").Op("=").Append(jen.Id("values"), resolvedValue(proj, jen.Id("value"), elem)),
+		)
+		group.Return(jen.Id("values"))
+	case strings.HasPrefix(field.GoType, "*"):
+		elem := field.GoType[1:]
+		group.If(value.Clone().Op("==").Nil()).Block(jen.Return(jen.Nil()))
+		elemValue := jen.Op("*").Add(value.Clone())
+		if isTimeType(proj, elem) {
+			elemValue = jen.Parens(elemValue)
+		}
+		group.Id("value").Op(":=").Add(resolvedValue(proj, elemValue, elem))
+		group.Return(jen.Op("&").Id("value"))
+	default:
+		group.Return(resolvedValue(proj, value, field.GoType))
+	}
+}
+
+// graphqlResultType returns Go type of resolved field
+func graphqlResultType(proj *memdata.Project, field graphqlField) jen.Code {
+	switch {
+	case field.Link != nil && field.Link.Many:
+		return jen.Index().Op("*").Id(resolverType(field.Link.Target))
+	case field.Link != nil:
+		return jen.Op("*").Id(resolverType(field.Link.Target))
+	case field.Back != nil:
+		return jen.Index().Op("*").Id(resolverType(field.Back.Model))
+	case field.Type == "ID!":
+		return jen.String()
+	case field.Type == "[ID!]!":
+		return jen.Index().String()
+	case strings.HasPrefix(field.GoType, "[]"):
+		return jen.Index().Id(graphqlGoType(graphqlScalar(proj, field.GoType[2:])))
+	case strings.HasPrefix(field.GoType, "*"):
+		return jen.Op("*").Id(graphqlGoType(graphqlScalar(proj, field.GoType[1:])))
+	}
+	return jen.Id(graphqlGoType(graphqlScalar(proj, field.GoType)))
+}
+
+// generateGraphQLResolvers defines root resolver of Query and resolvers of model types. Links of items resolved
+// together (items of one list) are loaded together by batch under one read lock.
+func generateGraphQLResolvers(proj *memdata.Project) jen.Code {
+	rootType := rootResolverType(proj)
+	code := jen.Comment(proj.Name + "GraphQLSchema is GraphQL schema (SDL) served by " + rootType).Line()
+	code.Const().Id(proj.Name + "GraphQLSchema").Op("=").Lit(GenerateGraphQL(proj)).Line()
+	code.Comment(rootType + " is root resolver of Query of " + proj.Name + "GraphQLSchema. Fields are resolved by methods with").Line()
+	code.Comment("the same names (ex: graph-gophers/graphql-go). Integers of more than 32 bits are resolved as Float, time as").Line()
+	code.Comment("String in RFC 3339 format.").Line()
+	code.Type().Id(rootType).Struct(
+		jen.Id("project").Op("*").Id("impl" + proj.Name),
+	).Line()
+	code.Comment("New" + rootType + " creates root resolver over the project created by New" + proj.Name).Line()
+	code.Func().Id("New" + rootType).Params(jen.Id("project").Id(proj.Name)).Op("*").Id(rootType).Block(
+		jen.Return().Op("&").Id(rootType).Values(jen.Id("project").Op(":").Id("project").Op(".").Parens(jen.Op("*").Id("impl" + proj.Name))),
+	).Line()
+	rootRecv := func() *jen.Statement { return jen.Parens(jen.Id("resolver").Op("*").Id(rootType)) }
+	code.Func().Add(rootRecv()).Id("read").Params(jen.Id("reader").Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name))).BlockFunc(func(readFunc *jen.Group) {
+		generateRead(readFunc, proj, jen.Id("resolver").Dot("project"))
+	}).Line()
+	for _, model := range proj.Models {
+		keyType := model.FieldType(model.Indexed)
+		batch := batchType(model)
+		// item by key
+		code.Func().Add(rootRecv()).Id(model.Name).Params(jen.Id("args").Struct(jen.Id(model.Indexed).String())).Params(jen.Op("*").Id(resolverType(model)), jen.Error()).BlockFunc(func(getFunc *jen.Group) {
+			generateParseKey(getFunc, model, jen.Id("args").Dot(model.Indexed), jen.Return(jen.Nil(), jen.Err()))
+			getFunc.Var().Id("item").Op("*").Id(model.Name)
+			getFunc.Id("resolver").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name)).Block(
+				jen.Id("item").Op("=").Id("project").Dot("get" + model.Name).Call(jen.Id("key")),
+			))
+			getFunc.If(jen.Id("item").Op("==").Nil()).Block(jen.Return(jen.Nil(), jen.Nil()))
+			getFunc.Return(jen.Op("&").Id(resolverType(model)).Values(
+				jen.Id("item").Op(":").Id("item"),
+				jen.Id("batch").Op(":").Op("&").Id(batch).Values(jen.Id("root").Op(":").Id("resolver"), jen.Id("items").Op(":").Index().Op("*").Id(model.Name).Values(jen.Id("item"))),
+			), jen.Nil())
+		}).Line()
+		// all items in order of keys
+		code.Func().Add(rootRecv()).Id(model.Name + "s").Params().Index().Op("*").Id(resolverType(model)).BlockFunc(func(listFunc *jen.Group) {
+			listFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+			listFunc.Id("resolver").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name)).Block(
+				jen.Id("project").Dot("ascend"+model.Name).Call(jen.Nil(), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+					jen.Return().True(),
+				)),
+			))
+			listFunc.Return().Parens(jen.Op("&").Id(batch).Values(jen.Id("root").Op(":").Id("resolver"), jen.Id("items").Op(":").Id("items"))).Dot("resolvers").Call()
+		}).Line()
+
+		// batch of items resolved together
+		fields := graphqlFields(model)
+		code.Comment(batch + " is set of items resolved together: links of all items are loaded on first access").Line()
+		code.Type().Id(batch).StructFunc(func(st *jen.Group) {
+			st.Id("root").Op("*").Id(rootType)
+			st.Id("items").Index().Op("*").Id(model.Name)
+			for _, field := range fields {
+				switch {
+				case field.Link != nil:
+					st.Id(memdata.ToLowerCamel(field.Method)+"Once").Qual("sync", "Once")
+					st.Id(memdata.ToLowerCamel(field.Method)).Map(jen.Id(field.Link.Target.FieldType(field.Link.Target.Indexed))).Op("*").Id(field.Link.Target.Name)
+					st.Id(memdata.ToLowerCamel(field.Method) + "Batch").Op("*").Id(batchType(field.Link.Target))
+				case field.Back != nil:
+					st.Id(memdata.ToLowerCamel(field.Method)+"Once").Qual("sync", "Once")
+					st.Id(memdata.ToLowerCamel(field.Method)).Map(jen.Id(keyType)).Index().Op("*").Id(field.Back.Model.Name)
+					st.Id(memdata.ToLowerCamel(field.Method) + "Batch").Op("*").Id(batchType(field.Back.Model))
+				}
+			}
+		}).Line()
+		batchRecv := func() *jen.Statement { return jen.Parens(jen.Id("batch").Op("*").Id(batch)) }
+		code.Func().Add(batchRecv()).Id("resolvers").Params().Index().Op("*").Id(resolverType(model)).Block(
+			jen.Id("resolvers").Op(":=").Make(jen.Index().Op("*").Id(resolverType(model)), jen.Lit(0), jen.Len(jen.Id("batch").Dot("items"))),
+			jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("batch").Dot("items")).Block(
+				jen.Id("resolvers").Op("=").Append(jen.Id("resolvers"), jen.Op("&").Id(resolverType(model)).Values(jen.Id("item").Op(":").Id("item"), jen.Id("batch").Op(":").Id("batch"))),
+			),
+			jen.Return().Id("resolvers"),
+		).Line()
+		for _, field := range fields {
+			name := memdata.ToLowerCamel(field.Method)
+			switch {
+			case field.Link != nil:
+				target := field.Link.Target
+				load := func(group *jen.Group, key jen.Code) {
+					group.If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("batch").Dot(name).Index(key), jen.Id("ok")).Block(jen.Continue())
+					group.Id("target").Op(":=").Id("project").Dot("get" + target.Name).Call(key)
+					group.Id("batch").Dot(name).Index(key).Op("=").Id("target")
+					group.If(jen.Id("target").Op("!=").Nil()).Block(
+						jen.Id("targets").Op("=").Append(jen.Id("targets"), jen.Id("target")),
+					)
+				}
+				code.Func().Add(batchRecv()).Id("load" + field.Method).Params().Block(
+					jen.Id("batch").Dot(name + "Once").Dot("Do").Call(jen.Func().Params().BlockFunc(func(onceFunc *jen.Group) {
+						onceFunc.Id("batch").Dot(name).Op("=").Make(jen.Map(jen.Id(target.FieldType(target.Indexed))).Op("*").Id(target.Name))
+						onceFunc.Var().Id("targets").Index().Op("*").Id(target.Name)
+						onceFunc.Id("batch").Dot("root").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name)).BlockFunc(func(readFunc *jen.Group) {
+							readFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("batch").Dot("items")).BlockFunc(func(iter *jen.Group) {
+								if field.Link.Many {
+									iter.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("item").Dot(field.Link.Field)).BlockFunc(func(keys *jen.Group) {
+										load(keys, jen.Id("key"))
+									})
+								} else {
+									load(iter, jen.Id("item").Dot(field.Link.Field))
+								}
+							})
+						}))
+						onceFunc.Id("batch").Dot(name+"Batch").Op("=").Op("&").Id(batchType(target)).Values(jen.Id("root").Op(":").Id("batch").Dot("root"), jen.Id("items").Op(":").Id("targets"))
+					})),
+				).Line()
+			case field.Back != nil:
+				referrer := field.Back.Model
+				code.Func().Add(batchRecv()).Id("load" + field.Method).Params().Block(
+					jen.Id("batch").Dot(name + "Once").Dot("Do").Call(jen.Func().Params().Block(
+						jen.Id("batch").Dot(name).Op("=").Make(jen.Map(jen.Id(keyType)).Index().Op("*").Id(referrer.Name)),
+						jen.Var().Id("referrers").Index().Op("*").Id(referrer.Name),
+						jen.Id("batch").Dot("root").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl"+proj.Name)).Block(
+							jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("batch").Dot("items")).Block(
+								jen.If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("batch").Dot(name).Index(jen.Id("item").Dot(model.Indexed)), jen.Id("ok")).Block(jen.Continue()),
+								jen.Id("found").Op(":=").Index().Op("*").Id(referrer.Name).Values(),
+								jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot("find"+referrer.Name+"By"+field.Back.Name).Call(jen.Id("item").Dot(model.Indexed))).Block(
+									jen.If(jen.Id("referrer").Op(":=").Id("project").Dot("get"+referrer.Name).Call(jen.Id("key")), jen.Id("referrer").Op("!=").Nil()).Block(
+										jen.Id("found").Op("=").Append(jen.Id("found"), jen.Id("referrer")),
+									),
+								),
+								jen.Id("batch").Dot(name).Index(jen.Id("item").Dot(model.Indexed)).Op("=").Id("found"),
+								jen.Id("referrers").Op("=").Append(jen.Id("referrers"), jen.Id("found").Op("...")),
+							),
+						)),
+						jen.Id("batch").Dot(name+"Batch").Op("=").Op("&").Id(batchType(referrer)).Values(jen.Id("root").Op(":").Id("batch").Dot("root"), jen.Id("items").Op(":").Id("referrers")),
+					)),
+				).Line()
+			}
+		}
+
+		// resolver of type
+		recv := func() *jen.Statement { return jen.Parens(jen.Id("resolver").Op("*").Id(resolverType(model))) }
+		code.Comment(resolverType(model) + " resolves fields of " + model.Name + " type").Line()
+		code.Type().Id(resolverType(model)).Struct(
+			jen.Id("item").Op("*").Id(model.Name),
+			jen.Id("batch").Op("*").Id(batch),
+		).Line()
+		for _, field := range fields {
+			name := memdata.ToLowerCamel(field.Method)
+			code.Func().Add(recv()).Id(field.Method).Params().Add(graphqlResultType(proj, field)).BlockFunc(func(fieldFunc *jen.Group) {
+				switch {
+				case field.Link != nil && field.Link.Many:
+					target := field.Link.Target
+					fieldFunc.Id("resolver").Dot("batch").Dot("load" + field.Method).Call()
+					fieldFunc.Id("resolvers").Op(":=").Index().Op("*").Id(resolverType(target)).Values()
+					fieldFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("resolver").Dot("item").Dot(field.Link.Field)).Block(
+						jen.If(jen.Id("target").Op(":=").Id("resolver").Dot("batch").Dot(name).Index(jen.Id("key")), jen.Id("target").Op("!=").Nil()).Block(
+							jen.Id("resolvers").Op("=").Append(jen.Id("resolvers"), jen.Op("&").Id(resolverType(target)).Values(
+								jen.Id("item").Op(":").Id("target"),
+								jen.Id("batch").Op(":").Id("resolver").Dot("batch").Dot(name+"Batch"),
+							)),
+						),
+					)
+					fieldFunc.Return().Id("resolvers")
+				case field.Link != nil:
+					target := field.Link.Target
+					fieldFunc.Id("resolver").Dot("batch").Dot("load" + field.Method).Call()
+					fieldFunc.Id("target").Op(":=").Id("resolver").Dot("batch").Dot(name).Index(jen.Id("resolver").Dot("item").Dot(field.Link.Field))
+					fieldFunc.If(jen.Id("target").Op("==").Nil()).Block(jen.Return().Nil())
+					fieldFunc.Return().Op("&").Id(resolverType(target)).Values(
+						jen.Id("item").Op(":").Id("target"),
+						jen.Id("batch").Op(":").Id("resolver").Dot("batch").Dot(name+"Batch"),
+					)
+				case field.Back != nil:
+					referrer := field.Back.Model
+					fieldFunc.Id("resolver").Dot("batch").Dot("load" + field.Method).Call()
+					fieldFunc.Id("resolvers").Op(":=").Index().Op("*").Id(resolverType(referrer)).Values()
+					fieldFunc.For(jen.List(jen.Id("_"), jen.Id("referrer")).Op(":=").Range().Id("resolver").Dot("batch").Dot(name).Index(jen.Id("resolver").Dot("item").Dot(model.Indexed))).Block(
+						jen.Id("resolvers").Op("=").Append(jen.Id("resolvers"), jen.Op("&").Id(resolverType(referrer)).Values(
+							jen.Id("item").Op(":").Id("referrer"),
+							jen.Id("batch").Op(":").Id("resolver").Dot("batch").Dot(name+"Batch"),
+						)),
+					)
+					fieldFunc.Return().Id("resolvers")
+				default:
+					generateResolveField(fieldFunc, proj, field, jen.Id("resolver").Dot("item").Dot(field.Field))
+				}
+			}).Line()
+		}
+	}
+	return code
+}
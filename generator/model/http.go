@@ -0,0 +1,333 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"sort"
+	"strings"
+)
+
+func httpHandlerType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Handler"
+}
+
+// resourcePath is path of model collection in REST API
+func resourcePath(model *memdata.Model) string {
+	return "/" + strings.ToLower(model.Name) + "s"
+}
+
+func responseType(model *memdata.Model) string {
+	return memdata.ToLowerCamel(model.Project.Name) + model.Name + "Response"
+}
+
+type modelLink struct {
+	Name   string         // name of link (ref or many)
+	Field  string         // name of struct field with key(s)
+	Many   bool           // field is a slice of keys
+	Target *memdata.Model // referenced model
+}
+
+// modelLinks lists ref and many links of model in order of names
+func modelLinks(model *memdata.Model) []modelLink {
+	var links []modelLink
+	for name, target := range model.Ref {
+		targetModel := model.Project.Model(target)
+		links = append(links, modelLink{Name: name, Field: name + targetModel.Indexed, Target: targetModel})
+	}
+	for name, target := range model.HasMany {
+		targetModel := model.Project.Model(target)
+		links = append(links, modelLink{Name: name, Field: name + targetModel.Indexed, Many: true, Target: targetModel})
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].Name < links[j].Name
+	})
+	return links
+}
+
+// checkHTTP checks that project is safe for concurrent requests and keys of models could be parsed from path
+func checkHTTP(proj *memdata.Project) {
+	if !proj.Synchronized && !proj.Transactional {
+		// handler serves requests concurrently
+		panic("http handler requires synchronized or transactional project")
+	}
+	for _, model := range proj.Models {
+		if !isOrderedType(model.FieldType(model.Indexed)) {
+			panic("http handler requires number or string key of " + model.Name)
+		}
+	}
+}
+
+// generateParseKey parses key of model from string source. Statements of onError are executed if key is invalid.
+func generateParseKey(group *jen.Group, model *memdata.Model, source jen.Code, onError ...jen.Code) {
+	keyType := model.FieldType(model.Indexed)
+	var parse jen.Code
+	switch {
+	case keyType == "string":
+		group.Id("key").Op(":=").Add(source)
+		return
+	case keyType == "float32" || keyType == "float64":
+		parse = jen.Qual("strconv", "ParseFloat").Call(source, jen.Lit(64))
+	case isSignedType(keyType):
+		parse = jen.Qual("strconv", "ParseInt").Call(source, jen.Lit(10), jen.Lit(64))
+	default:
+		parse = jen.Qual("strconv", "ParseUint").Call(source, jen.Lit(10), jen.Lit(64))
+	}
+	group.List(jen.Id("parsed"), jen.Err()).Op(":=").Add(parse)
+	group.If(jen.Err().Op("!=").Nil()).Block(onError...)
+	group.Id("key").Op(":=").Id(keyType).Call(jen.Id("parsed"))
+}
+
+// generateRead calls reader with the project (or the view in transactional mode) under read lock
+func generateRead(group *jen.Group, proj *memdata.Project, project *jen.Statement) {
+	if proj.Transactional {
+		group.Id("view").Op(":=").Add(project.Clone()).Dot("ReadLock").Call()
+		group.Defer().Id("view").Dot("ReadUnlock").Call()
+		group.Id("project").Op(":=").Id("view").Op(".").Parens(jen.Op("*").Id("impl" + proj.Name))
+		if proj.MVCC {
+			// committed state is shared with the writer
+			group.Id("project").Dot("_commit").Dot("RLock").Call()
+			group.Defer().Id("project").Dot("_commit").Dot("RUnlock").Call()
+		}
+		group.Id("reader").Call(jen.Id("project"))
+		return
+	}
+	if proj.Synchronized {
+		group.Add(project.Clone()).Dot("_lock").Dot("RLock").Call()
+		group.Defer().Add(project.Clone()).Dot("_lock").Dot("RUnlock").Call()
+	}
+	group.Id("reader").Call(project.Clone())
+}
+
+// generateHTTPHandler defines REST API (JSON) over the project: list, get, insert, update and delete of each model.
+// Writes of request are done in one transaction in transactional mode.
+func generateHTTPHandler(proj *memdata.Project) jen.Code {
+	typeName := httpHandlerType(proj)
+	errNotFound := "err" + proj.Name + "NotFound"
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("handler").Op("*").Id(typeName)) }
+	handlerParams := func() *jen.Statement {
+		return jen.Params(jen.Id("writer").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"))
+	}
+	failWith := func(status string, err jen.Code) jen.Code {
+		return jen.Id("handler").Dot("fail").Call(jen.Id("writer"), jen.Qual("net/http", status), err)
+	}
+	pathKey := jen.Id("request").Dot("PathValue").Call(jen.Lit("key"))
+	badKey := []jen.Code{failWith("StatusBadRequest", jen.Err()), jen.Return()}
+	// context of request is passed to writes in errors mode
+	withRequest := func(args ...jen.Code) []jen.Code {
+		if !proj.Errors {
+			return args
+		}
+		return append([]jen.Code{jen.Id("request").Dot("Context").Call()}, args...)
+	}
+	// writes of request are done by writer and errors of writer are mapped to statuses
+	write := func(group *jen.Group, body ...jen.Code) {
+		group.If(
+			jen.Err().Op(":=").Id("handler").Dot("write").Call(withRequest(jen.Func().Params(jen.Id("project").Id(proj.Name+"ReadWriter")).Error().Block(body...))...),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Id("handler").Dot("fail").Call(jen.Id("writer"), jen.Id("handler").Dot("status").Call(jen.Err()), jen.Err()),
+			jen.Return(),
+		)
+	}
+
+	code := jen.Var().Id(errNotFound).Op("=").Qual("errors", "New").Call(jen.Lit("not found")).Line()
+	code.Type().Id(typeName).Struct(
+		jen.Id("project").Op("*").Id("impl" + proj.Name),
+	).Line()
+	var routes []string
+	for _, model := range proj.Models {
+		routes = append(routes, resourcePath(model))
+	}
+	code.Comment("New" + proj.Name + "Handler returns HTTP handler of REST API with JSON bodies over the project created by New" + proj.Name + ".").Line()
+	code.Comment("Each model has collection (" + strings.Join(routes, ", ") + "): GET of collection lists items, POST inserts item,").Line()
+	code.Comment("GET, PUT and DELETE of collection/{key} read, update and remove item. Links of returned items are expanded by").Line()
+	code.Comment("names in expand query parameter (ex: ?expand=From,To).").Line()
+	code.Func().Id("New"+proj.Name+"Handler").Params(jen.Id("project").Id(proj.Name)).Qual("net/http", "Handler").BlockFunc(func(newFunc *jen.Group) {
+		newFunc.Id("handler").Op(":=").Op("&").Id(typeName).Values(jen.Id("project").Op(":").Id("project").Op(".").Parens(jen.Op("*").Id("impl" + proj.Name)))
+		newFunc.Id("mux").Op(":=").Qual("net/http", "NewServeMux").Call()
+		for _, model := range proj.Models {
+			path := resourcePath(model)
+			newFunc.Id("mux").Dot("HandleFunc").Call(jen.Lit("GET "+path), jen.Id("handler").Dot("list"+model.Name))
+			newFunc.Id("mux").Dot("HandleFunc").Call(jen.Lit("POST "+path), jen.Id("handler").Dot("insert"+model.Name))
+			newFunc.Id("mux").Dot("HandleFunc").Call(jen.Lit("GET "+path+"/{key}"), jen.Id("handler").Dot("get"+model.Name))
+			newFunc.Id("mux").Dot("HandleFunc").Call(jen.Lit("PUT "+path+"/{key}"), jen.Id("handler").Dot("update"+model.Name))
+			newFunc.Id("mux").Dot("HandleFunc").Call(jen.Lit("DELETE "+path+"/{key}"), jen.Id("handler").Dot("remove"+model.Name))
+		}
+		newFunc.Return().Id("mux")
+	}).Line()
+	// reads are done by unexported functions of the project under read lock
+	code.Func().Add(recv()).Id("read").Params(jen.Id("reader").Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name))).BlockFunc(func(readFunc *jen.Group) {
+		generateRead(readFunc, proj, jen.Id("handler").Dot("project"))
+	}).Line()
+	// writes of request are committed together or discarded on error
+	code.Func().Add(recv()).Id("write").Params(withContext(proj, jen.Id("writer").Func().Params(jen.Id("project").Id(proj.Name+"ReadWriter")).Error())...).Error().BlockFunc(func(writeFunc *jen.Group) {
+		if !proj.Transactional {
+			writeFunc.Return().Id("writer").Call(jen.Id("handler").Dot("project"))
+			return
+		}
+		writeFunc.Id("tx").Op(":=").Id("handler").Dot("project").Dot("ReadWriteLock").Call()
+		writeFunc.If(jen.Err().Op(":=").Id("writer").Call(jen.Id("tx")), jen.Err().Op("!=").Nil()).Block(
+			jen.Id("tx").Dot("Discard").Call(),
+			jen.Return().Err(),
+		)
+		if proj.Errors {
+			// failed commit keeps the transaction
+			writeFunc.If(jen.Err().Op(":=").Id("tx").Dot("Commit").Call(jen.Id("ctx")), jen.Err().Op("!=").Nil()).Block(
+				jen.Id("tx").Dot("Discard").Call(),
+				jen.Return().Err(),
+			)
+			writeFunc.Return().Nil()
+		} else if proj.WAL {
+			writeFunc.Return().Id("tx").Dot("Commit").Call()
+		} else {
+			writeFunc.Id("tx").Dot("Commit").Call()
+			writeFunc.Return().Nil()
+		}
+	}).Line()
+	code.Func().Add(recv()).Id("respond").Params(jen.Id("writer").Qual("net/http", "ResponseWriter"), jen.Id("status").Int(), jen.Id("value").Interface()).Block(
+		jen.Id("writer").Dot("Header").Call().Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit("application/json")),
+		jen.Id("writer").Dot("WriteHeader").Call(jen.Id("status")),
+		jen.Id("_").Op("=").Qual("encoding/json", "NewEncoder").Call(jen.Id("writer")).Dot("Encode").Call(jen.Id("value")),
+	).Line()
+	code.Func().Add(recv()).Id("fail").Params(jen.Id("writer").Qual("net/http", "ResponseWriter"), jen.Id("status").Int(), jen.Err().Error()).Block(
+		jen.Id("handler").Dot("respond").Call(jen.Id("writer"), jen.Id("status"), jen.Map(jen.String()).String().Values(jen.Lit("error").Op(":").Err().Dot("Error").Call())),
+	).Line()
+	// status of errors returned by writers
+	code.Func().Add(recv()).Id("status").Params(jen.Err().Error()).Int().Block(
+		jen.Switch(jen.Err().Op(".").Parens(jen.Type())).Block(
+			jen.Case(jen.Op("*").Id("ErrValidation"), jen.Op("*").Id("ErrMissingReference")).Block(
+				jen.Return().Qual("net/http", "StatusUnprocessableEntity"),
+			),
+			jen.Case(jen.Op("*").Id("ErrUniqueViolation"), jen.Op("*").Id("ErrRestrictViolation"), jen.Op("*").Id("ErrConflict")).Block(
+				jen.Return().Qual("net/http", "StatusConflict"),
+			),
+		),
+		jen.If(jen.Err().Op("==").Id(errNotFound)).Block(
+			jen.Return().Qual("net/http", "StatusNotFound"),
+		),
+		jen.Return().Qual("net/http", "StatusInternalServerError"),
+	).Line()
+	// names of expanded links
+	code.Func().Add(recv()).Id("expand").Params(jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("links").Op("...").String()).Params(jen.Map(jen.String()).Bool(), jen.Error()).Block(
+		jen.Id("expand").Op(":=").Make(jen.Map(jen.String()).Bool()),
+		jen.For(jen.List(jen.Id("_"), jen.Id("param")).Op(":=").Range().Id("request").Dot("URL").Dot("Query").Call().Index(jen.Lit("expand"))).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Qual("strings", "Split").Call(jen.Id("param"), jen.Lit(","))).Block(
+				jen.If(jen.Id("name").Op("==").Lit("")).Block(jen.Continue()),
+				jen.Id("known").Op(":=").False(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("link")).Op(":=").Range().Id("links")).Block(
+					jen.Id("known").Op("=").Id("known").Op("||").Id("link").Op("==").Id("name"),
+				),
+				jen.If(jen.Op("!").Id("known")).Block(
+					jen.Return(jen.Nil(), jen.Qual("errors", "New").Call(jen.Lit("unknown link ").Op("+").Id("name"))),
+				),
+				jen.Id("expand").Index(jen.Id("name")).Op("=").True(),
+			),
+		),
+		jen.Return(jen.Id("expand"), jen.Nil()),
+	).Line()
+
+	for _, model := range proj.Models {
+		links := modelLinks(model)
+		var linkNames []jen.Code
+		for _, link := range links {
+			linkNames = append(linkNames, jen.Lit(link.Name))
+		}
+		// item with expanded links
+		code.Type().Id(responseType(model)).StructFunc(func(st *jen.Group) {
+			st.Op("*").Id(model.Name)
+			for _, link := range links {
+				tag := map[string]string{"json": link.Name + ",omitempty"}
+				if link.Many {
+					st.Id(link.Name).Index().Op("*").Id(link.Target.Name).Tag(tag)
+				} else {
+					st.Id(link.Name).Op("*").Id(link.Target.Name).Tag(tag)
+				}
+			}
+		}).Line()
+		code.Func().Add(recv()).Id("response"+model.Name).Params(jen.Id("project").Op("*").Id("impl"+proj.Name), jen.Id("item").Op("*").Id(model.Name), jen.Id("expand").Map(jen.String()).Bool()).Op("*").Id(responseType(model)).BlockFunc(func(responseFunc *jen.Group) {
+			responseFunc.Id("response").Op(":=").Op("&").Id(responseType(model)).Values(jen.Id(model.Name).Op(":").Id("item"))
+			for _, link := range links {
+				if link.Many {
+					responseFunc.If(jen.Id("expand").Index(jen.Lit(link.Name))).Block(
+						jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("item").Dot(link.Field)).Block(
+							jen.If(jen.Id("target").Op(":=").Id("project").Dot("get"+link.Target.Name).Call(jen.Id("key")), jen.Id("target").Op("!=").Nil()).Block(
+								jen.Id("response").Dot(link.Name).Op("=").Append(jen.Id("response").Dot(link.Name), jen.Id("target")),
+							),
+						),
+					)
+				} else {
+					responseFunc.If(jen.Id("expand").Index(jen.Lit(link.Name))).Block(
+						jen.Id("response").Dot(link.Name).Op("=").Id("project").Dot("get" + link.Target.Name).Call(jen.Id("item").Dot(link.Field)),
+					)
+				}
+			}
+			responseFunc.Return().Id("response")
+		}).Line()
+		// list
+		code.Func().Add(recv()).Id("list" + model.Name).Add(handlerParams()).BlockFunc(func(listFunc *jen.Group) {
+			listFunc.List(jen.Id("expand"), jen.Err()).Op(":=").Id("handler").Dot("expand").Call(append([]jen.Code{jen.Id("request")}, linkNames...)...)
+			listFunc.If(jen.Err().Op("!=").Nil()).Block(failWith("StatusBadRequest", jen.Err()), jen.Return())
+			listFunc.Id("items").Op(":=").Index().Op("*").Id(responseType(model)).Values()
+			listFunc.Id("handler").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name)).Block(
+				jen.Id("project").Dot("ascend"+model.Name).Call(jen.Nil(), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("handler").Dot("response"+model.Name).Call(jen.Id("project"), jen.Id("item"), jen.Id("expand"))),
+					jen.Return().True(),
+				)),
+			))
+			listFunc.Id("handler").Dot("respond").Call(jen.Id("writer"), jen.Qual("net/http", "StatusOK"), jen.Id("items"))
+		}).Line()
+		// get
+		code.Func().Add(recv()).Id("get" + model.Name).Add(handlerParams()).BlockFunc(func(getFunc *jen.Group) {
+			generateParseKey(getFunc, model, pathKey, badKey...)
+			getFunc.List(jen.Id("expand"), jen.Err()).Op(":=").Id("handler").Dot("expand").Call(append([]jen.Code{jen.Id("request")}, linkNames...)...)
+			getFunc.If(jen.Err().Op("!=").Nil()).Block(failWith("StatusBadRequest", jen.Err()), jen.Return())
+			getFunc.Var().Id("response").Op("*").Id(responseType(model))
+			getFunc.Id("handler").Dot("read").Call(jen.Func().Params(jen.Id("project").Op("*").Id("impl" + proj.Name)).Block(
+				jen.If(jen.Id("item").Op(":=").Id("project").Dot("get"+model.Name).Call(jen.Id("key")), jen.Id("item").Op("!=").Nil()).Block(
+					jen.Id("response").Op("=").Id("handler").Dot("response"+model.Name).Call(jen.Id("project"), jen.Id("item"), jen.Id("expand")),
+				),
+			))
+			getFunc.If(jen.Id("response").Op("==").Nil()).Block(failWith("StatusNotFound", jen.Id(errNotFound)), jen.Return())
+			getFunc.Id("handler").Dot("respond").Call(jen.Id("writer"), jen.Qual("net/http", "StatusOK"), jen.Id("response"))
+		}).Line()
+		// insert
+		code.Func().Add(recv()).Id("insert" + model.Name).Add(handlerParams()).BlockFunc(func(insertFunc *jen.Group) {
+			insertFunc.Var().Id("item").Id(model.Name)
+			insertFunc.If(jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("request").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("item")), jen.Err().Op("!=").Nil()).Block(
+				failWith("StatusBadRequest", jen.Err()),
+				jen.Return(),
+			)
+			write(insertFunc,
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("project").Dot("Insert"+model.Name).Call(withRequest(jen.Op("&").Id("item"))...),
+				jen.Return().Err(),
+			)
+			insertFunc.Id("handler").Dot("respond").Call(jen.Id("writer"), jen.Qual("net/http", "StatusCreated"), jen.Op("&").Id("item"))
+		}).Line()
+		// update
+		code.Func().Add(recv()).Id("update" + model.Name).Add(handlerParams()).BlockFunc(func(updateFunc *jen.Group) {
+			generateParseKey(updateFunc, model, pathKey, badKey...)
+			updateFunc.Var().Id("item").Id(model.Name)
+			updateFunc.If(jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("request").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("item")), jen.Err().Op("!=").Nil()).Block(
+				failWith("StatusBadRequest", jen.Err()),
+				jen.Return(),
+			)
+			updateFunc.Id("item").Dot(model.Indexed).Op("=").Id("key")
+			write(updateFunc,
+				jen.If(jen.Id("project").Dot(model.Name).Call(jen.Id("key")).Op("==").Nil()).Block(jen.Return().Id(errNotFound)),
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("project").Dot("Update"+model.Name).Call(withRequest(jen.Op("&").Id("item"))...),
+				jen.Return().Err(),
+			)
+			updateFunc.Id("handler").Dot("respond").Call(jen.Id("writer"), jen.Qual("net/http", "StatusOK"), jen.Op("&").Id("item"))
+		}).Line()
+		// remove
+		code.Func().Add(recv()).Id("remove" + model.Name).Add(handlerParams()).BlockFunc(func(removeFunc *jen.Group) {
+			generateParseKey(removeFunc, model, pathKey, badKey...)
+			write(removeFunc,
+				jen.If(jen.Id("project").Dot(model.Name).Call(jen.Id("key")).Op("==").Nil()).Block(jen.Return().Id(errNotFound)),
+				jen.Return().Id("project").Dot("Remove"+model.Name).Call(withRequest(jen.Id("key"))...),
+			)
+			removeFunc.Id("writer").Dot("WriteHeader").Call(jen.Qual("net/http", "StatusNoContent"))
+		}).Line()
+	}
+	return code
+}
@@ -0,0 +1,245 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// modelIndex describes secondary index of model: by plain field or by keys of referenced models
+type modelIndex struct {
+	Model   *memdata.Model
+	Name    string         // index name (By<Name>)
+	Field   string         // field of model with indexed value(s)
+	Type    string         // type of indexed value
+	Many    bool           // field is a slice of values
+	Ordered bool           // values are ordered by red-black tree
+	Target  *memdata.Model // referenced model (nil for plain fields)
+}
+
+func (index modelIndex) TypeName() string {
+	return memdata.ToLowerCamel(index.Model.Name) + "By" + index.Name + "Index"
+}
+
+func (index modelIndex) FieldName() string {
+	return "index" + index.Model.Name + "By" + index.Name
+}
+
+func (index modelIndex) PendingName() string {
+	return "_pending" + index.Model.Name + "By" + index.Name
+}
+
+func (index modelIndex) TreeName() string {
+	return index.Model.Name + "By" + index.Name + "Tree"
+}
+
+func (index modelIndex) Constructor() string {
+	return "new" + index.Model.Name + "By" + index.Name + "Index"
+}
+
+// modelIndexes lists secondary indexes by fields and indexes by references (foreign keys) of the model
+func modelIndexes(model *memdata.Model) []modelIndex {
+	var indexes []modelIndex
+	for _, field := range model.Indexes {
+		indexes = append(indexes, modelIndex{Model: model, Name: field, Field: field, Type: model.FieldType(field), Ordered: contains(model.Ordered, field)})
+	}
+	for _, field := range sortedKeys(model.Ref) {
+		target := model.Project.Model(model.Ref[field])
+		indexes = append(indexes, modelIndex{Model: model, Name: field, Field: field + target.Indexed, Type: target.FieldType(target.Indexed), Target: target})
+	}
+	for _, field := range sortedKeys(model.HasMany) {
+		target := model.Project.Model(model.HasMany[field])
+		indexes = append(indexes, modelIndex{Model: model, Name: field, Field: field + target.Indexed, Type: target.FieldType(target.Indexed), Many: true, Target: target})
+	}
+	return indexes
+}
+
+// modelBackReferences lists indexes of another models by references (foreign keys) to the model
+func modelBackReferences(model *memdata.Model) []modelIndex {
+	var indexes []modelIndex
+	for _, referrer := range model.Project.Models {
+		for _, index := range modelIndexes(referrer) {
+			if index.Target == model {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	return indexes
+}
+
+func hasIndexes(proj *memdata.Project) bool {
+	for _, model := range proj.Models {
+		if len(modelIndexes(model)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// generateIndexTypes defines one secondary index structure for each index of each model
+func generateIndexTypes(proj *memdata.Project) *jen.Statement {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		for _, index := range modelIndexes(model) {
+			typeName := index.TypeName()
+			valueType := proj.Qual(index.Type)
+			storedType := jen.Add(valueType)
+			if index.Many {
+				storedType = jen.Index().Add(valueType)
+			}
+			// define struct { keys map[value]set(id), values map[id]value(s), order tree[value]set(id) }
+			code.Type().Id(typeName).StructFunc(func(st *jen.Group) {
+				st.Id("keys").Map(valueType).Map(keyType).Struct()
+				st.Id("values").Map(keyType).Add(storedType)
+				if index.Ordered {
+					st.Id("order").Op("*").Id(index.TreeName())
+				}
+			}).Line()
+			// define constructor
+			code.Func().Id(index.Constructor()).Params().Op("*").Id(typeName).BlockFunc(func(init *jen.Group) {
+				init.Return().Op("&").Id(typeName).ValuesFunc(func(fields *jen.Group) {
+					fields.Id("keys").Op(":").Make(jen.Map(valueType).Map(keyType).Struct())
+					fields.Id("values").Op(":").Make(jen.Map(keyType).Add(storedType))
+					if index.Ordered {
+						fields.Id("order").Op(":").Id("New" + index.TreeName()).Call()
+					}
+				})
+			}).Line()
+			// put (id, value(s)) - replaces previous value(s) of id
+			code.Func().Params(jen.Id("index").Op("*").Id(typeName)).Id("put").Params(jen.Id(keyName).Add(keyType), jen.Id("value").Add(storedType)).BlockFunc(func(putFunc *jen.Group) {
+				putFunc.Id("index").Dot("remove").Call(jen.Id(keyName))
+				link := func(group *jen.Group, value jen.Code) {
+					group.List(jen.Id("keys"), jen.Id("ok")).Op(":=").Id("index").Dot("keys").Index(value)
+					group.If(jen.Op("!").Id("ok")).BlockFunc(func(create *jen.Group) {
+						create.Id("keys").Op("=").Make(jen.Map(keyType).Struct())
+						create.Id("index").Dot("keys").Index(value).Op("=").Id("keys")
+						if index.Ordered {
+							create.Id("index").Dot("order").Dot("Put").Call(value, jen.Id("keys"))
+						}
+					})
+					group.Id("keys").Index(jen.Id(keyName)).Op("=").Struct().Values()
+				}
+				if index.Many {
+					putFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("value")).BlockFunc(func(iter *jen.Group) {
+						link(iter, jen.Id("item"))
+					})
+					putFunc.Id("index").Dot("values").Index(jen.Id(keyName)).Op("=").Append(jen.Add(storedType).Call(jen.Nil()), jen.Id("value").Op("..."))
+				} else {
+					link(putFunc, jen.Id("value"))
+					putFunc.Id("index").Dot("values").Index(jen.Id(keyName)).Op("=").Id("value")
+				}
+			}).Line()
+			// remove (id)
+			code.Func().Params(jen.Id("index").Op("*").Id(typeName)).Id("remove").Params(jen.Id(keyName).Add(keyType)).BlockFunc(func(removeFunc *jen.Group) {
+				removeFunc.List(jen.Id("value"), jen.Id("ok")).Op(":=").Id("index").Dot("values").Index(jen.Id(keyName))
+				removeFunc.If(jen.Op("!").Id("ok")).Block(jen.Return())
+				unlink := func(group *jen.Group, value jen.Code) {
+					group.Id("keys").Op(":=").Id("index").Dot("keys").Index(value)
+					group.Delete(jen.Id("keys"), jen.Id(keyName))
+					group.If(jen.Len(jen.Id("keys")).Op("==").Lit(0)).BlockFunc(func(drop *jen.Group) {
+						drop.Delete(jen.Id("index").Dot("keys"), value)
+						if index.Ordered {
+							drop.Id("index").Dot("order").Dot("Remove").Call(value)
+						}
+					})
+				}
+				if index.Many {
+					removeFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("value")).BlockFunc(func(iter *jen.Group) {
+						unlink(iter, jen.Id("item"))
+					})
+				} else {
+					unlink(removeFunc, jen.Id("value"))
+				}
+				removeFunc.Delete(jen.Id("index").Dot("values"), jen.Id(keyName))
+			}).Line()
+			// find (value) -> ids (sorted if possible)
+			code.Func().Params(jen.Id("index").Op("*").Id(typeName)).Id("find").Params(jen.Id("value").Add(valueType)).Index().Add(keyType).BlockFunc(func(findFunc *jen.Group) {
+				findFunc.Id("keys").Op(":=").Id("index").Dot("keys").Index(jen.Id("value"))
+				findFunc.Id("result").Op(":=").Make(jen.Index().Add(keyType), jen.Lit(0), jen.Len(jen.Id("keys")))
+				findFunc.For(jen.Id("key").Op(":=").Range().Id("keys")).Block(
+					jen.Id("result").Op("=").Append(jen.Id("result"), jen.Id("key")),
+				)
+				generateSortKeys(findFunc, model.FieldType(model.Indexed), "result")
+				findFunc.Return().Id("result")
+			}).Line()
+			if index.Ordered {
+				code.Add(generateOrderedIndex(index))
+			}
+		}
+	}
+	return code
+}
+
+// generateIndexFind defines lookup of keys by indexed value for each index of each model.
+// In transactional mode committed values are overlapped by pending changes from the transaction log.
+func generateIndexFind(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		for _, index := range modelIndexes(model) {
+			code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("find" + model.Name + "By" + index.Name).Params(jen.Id("value").Add(proj.Qual(index.Type))).Index().Add(keyType).BlockFunc(func(findFunc *jen.Group) {
+				if !proj.Transactional {
+					findFunc.Return().Id("project").Dot(index.FieldName()).Dot("find").Call(jen.Id("value"))
+					return
+				}
+				findFunc.Id("keys").Op(":=").Id("project").Dot(index.FieldName()).Dot("find").Call(jen.Id("value"))
+				findFunc.Id("result").Op(":=").Id("keys").Index(jen.Empty(), jen.Lit(0))
+				findFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys")).BlockFunc(func(iter *jen.Group) {
+					// committed value changed in transaction
+					iter.If(jen.List(jen.Id("_"), jen.Id("changed")).Op(":=").Id("project").Dot("_pending"+model.Name).Index(jen.Id("key")), jen.Op("!").Id("changed")).Block(
+						jen.Id("result").Op("=").Append(jen.Id("result"), jen.Id("key")),
+					)
+				})
+				findFunc.Id("result").Op("=").Append(jen.Id("result"), jen.Id("project").Dot(index.PendingName()).Dot("find").Call(jen.Id("value")).Op("..."))
+				generateSortKeys(findFunc, model.FieldType(model.Indexed), "result")
+				findFunc.Return().Id("result")
+			}).Line()
+		}
+	}
+	return code
+}
+
+// generateIndexUpdate puts (or removes if item is nil) values of all indexes of the model
+func generateIndexUpdate(group *jen.Group, model *memdata.Model, key jen.Code, item jen.Code) {
+	for _, index := range modelIndexes(model) {
+		if item == nil {
+			group.Id("project").Dot(index.FieldName()).Dot("remove").Call(key)
+		} else {
+			group.Id("project").Dot(index.FieldName()).Dot("put").Call(key, jen.Add(item).Dot(index.Field))
+		}
+	}
+}
+
+// generateIndexApply updates indexes from committed transaction log
+func generateIndexApply(proj *memdata.Project) jen.Code {
+	return jen.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("applyIndexes").Params(jen.Id("batch").Index().Id(proj.Name + "LogEntity")).BlockFunc(func(batchFunc *jen.Group) {
+		batchFunc.For().List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch").BlockFunc(func(batchItem *jen.Group) {
+			for _, model := range proj.Models {
+				if len(modelIndexes(model)) == 0 {
+					continue
+				}
+				batchItem.If(jen.Id("tx").Dot(model.Name).Op("!=").Nil()).BlockFunc(func(modelChange *jen.Group) {
+					modelChange.Switch(jen.Id("tx").Dot(model.Name).Dot("Action")).BlockFunc(func(action *jen.Group) {
+						action.Case(jen.Id(proj.Name+"ActionInsert"), jen.Id(proj.Name+"ActionUpdate")).BlockFunc(func(operation *jen.Group) {
+							generateIndexUpdate(operation, model, jen.Id("tx").Dot(model.Name).Dot(model.Indexed), jen.Id("tx").Dot(model.Name).Dot("Item"))
+						})
+						action.Case(jen.Id(proj.Name + "ActionDelete")).BlockFunc(func(operation *jen.Group) {
+							generateIndexUpdate(operation, model, jen.Id("tx").Dot(model.Name).Dot(model.Indexed), nil)
+						})
+					})
+				})
+			}
+		})
+	}).Line()
+}
+
+// generateSortKeys sorts slice of keys of number or string type, order of other keys is undefined
+func generateSortKeys(group *jen.Group, keyType string, slice string) {
+	if !isOrderedType(keyType) {
+		return
+	}
+	group.Qual("sort", "Slice").Call(jen.Id(slice), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+		jen.Return().Id(slice).Index(jen.Id("i")).Op("<").Id(slice).Index(jen.Id("j")),
+	))
+}
@@ -0,0 +1,262 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"strings"
+)
+
+// checkKV checks that keys of models could be encoded with order preserving
+func checkKV(proj *memdata.Project) {
+	for _, model := range proj.Models {
+		if !isOrderedType(model.FieldType(model.Indexed)) {
+			panic("kv storage requires number or string key of " + model.Name)
+		}
+	}
+}
+
+// kvPrefix is prefix of keys of model items in KV engine
+func kvPrefix(model *memdata.Model) string {
+	return model.Name + "/"
+}
+
+// generateKVKey defines encoding of model key with model prefix: byte order of encoded keys is order of keys
+func generateKVKey(model *memdata.Model) jen.Code {
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	keyType := model.FieldType(model.Indexed)
+	prefix := jen.Index().Byte().Call(jen.Lit(kvPrefix(model)))
+	appendUint := func(value jen.Code) jen.Code {
+		return jen.Qual("encoding/binary", "BigEndian").Dot("AppendUint64").Call(prefix, value)
+	}
+	return jen.Func().Id(kvKeyFunc(model)).Params(jen.Id(keyName).Id(keyType)).Index().Byte().BlockFunc(func(keyFunc *jen.Group) {
+		switch {
+		case keyType == "string":
+			keyFunc.Return().Append(prefix, jen.Id(keyName).Op("..."))
+		case keyType == "float32" || keyType == "float64":
+			// negative numbers are inverted, positive numbers are after them
+			keyFunc.Id("bits").Op(":=").Qual("math", "Float64bits").Call(jen.Float64().Call(jen.Id(keyName)))
+			keyFunc.If(jen.Id("bits").Op(">>").Lit(63).Op("==").Lit(1)).Block(
+				jen.Id("bits").Op("=").Op("^").Id("bits"),
+			).Else().Block(
+				jen.Id("bits").Op("|=").Lit(1).Op("<<").Lit(63),
+			)
+			keyFunc.Return().Add(appendUint(jen.Id("bits")))
+		case keyType == "byte" || strings.HasPrefix(keyType, "uint"):
+			keyFunc.Return().Add(appendUint(jen.Uint64().Call(jen.Id(keyName))))
+		default:
+			// flipped sign bit puts negative numbers before positive
+			value := jen.Id(keyName)
+			if keyType != "int64" {
+				value = jen.Int64().Call(value)
+			}
+			keyFunc.Return().Add(appendUint(jen.Uint64().Call(value).Op("^").Parens(jen.Lit(1).Op("<<").Lit(63))))
+		}
+	}).Line()
+}
+
+func kvKeyFunc(model *memdata.Model) string {
+	return "kv" + model.Project.Name + model.Name + "Key"
+}
+
+// generateKV defines KV engine and codec interfaces, default codec, in-memory engine and storage of all models over
+// them. Storage interfaces have no errors, so errors of codec are panics. In errors mode writes of engine take context
+// and errors of engine and encoding are returned by storage, but errors of decoding are still panics.
+func generateKV(proj *memdata.Project) jen.Code {
+	kvType := proj.Name + "KV"
+	codecType := proj.Name + "Codec"
+	binaryCodec := proj.Name + "BinaryCodec"
+	memoryType := memdata.ToLowerCamel(proj.Name) + "MemoryKV"
+	objName := "KV" + proj.Name + "Storage"
+	bytes := func() *jen.Statement { return jen.Index().Byte() }
+	scanIterator := jen.Func().Params(jen.Id("key"), jen.Id("value").Index().Byte()).Bool()
+	// done ends write of in-memory engine: no errors in errors mode
+	done, result := jen.Return(), jen.Null()
+	if proj.Errors {
+		done, result = jen.Return().Nil(), jen.Return().Nil()
+	}
+
+	code := jen.Comment(kvType + " is key-value engine of KV storage. Scan iterates keys with prefix in ascending byte order until iterator returns false").Line()
+	code.Type().Id(kvType).Interface(
+		jen.Id("Get").Params(jen.Id("key").Add(bytes())).Params(bytes(), jen.Bool()),
+		jen.Id("Put").Params(withContext(proj, jen.Id("key"), jen.Id("value").Add(bytes()))...).Add(storageError(proj)),
+		jen.Id("Delete").Params(withContext(proj, jen.Id("key").Add(bytes()))...).Add(storageError(proj)),
+		jen.Id("Scan").Params(jen.Id("prefix").Add(bytes()), jen.Id("iterator").Add(scanIterator.Clone())),
+	).Line()
+	code.Comment(codecType + " encodes items to values of KV storage").Line()
+	code.Type().Id(codecType).Interface(
+		jen.Id("Marshal").Params(jen.Id("item").Interface()).Params(bytes(), jen.Error()),
+		jen.Id("Unmarshal").Params(jen.Id("data").Add(bytes()), jen.Id("item").Interface()).Error(),
+	).Line()
+	code.Comment(binaryCodec + " encodes items by their MarshalBinary and UnmarshalBinary").Line()
+	code.Type().Id(binaryCodec).Struct().Line()
+	code.Comment("Marshal returns binary representation of item").Line()
+	code.Func().Params(jen.Id(binaryCodec)).Id("Marshal").Params(jen.Id("item").Interface()).Params(bytes(), jen.Error()).Block(
+		jen.Return().Id("item").Assert(jen.Qual("encoding", "BinaryMarshaler")).Dot("MarshalBinary").Call(),
+	).Line()
+	code.Comment("Unmarshal restores item from binary representation").Line()
+	code.Func().Params(jen.Id(binaryCodec)).Id("Unmarshal").Params(jen.Id("data").Add(bytes()), jen.Id("item").Interface()).Error().Block(
+		jen.Return().Id("item").Assert(jen.Qual("encoding", "BinaryUnmarshaler")).Dot("UnmarshalBinary").Call(jen.Id("data")),
+	).Line()
+
+	// in-memory engine: map of values and sorted keys
+	code.Type().Id(memoryType).Struct(
+		jen.Id("lock").Qual("sync", "RWMutex"),
+		jen.Id("keys").Index().String(),
+		jen.Id("values").Map(jen.String()).Index().Byte(),
+	).Line()
+	code.Comment("NewMemory" + kvType + " creates in-memory KV engine").Line()
+	code.Func().Id("NewMemory" + kvType).Params().Id(kvType).Block(
+		jen.Return().Op("&").Id(memoryType).Values(jen.Id("values").Op(":").Make(jen.Map(jen.String()).Index().Byte())),
+	).Line()
+	memRecv := func() *jen.Statement { return jen.Params(jen.Id("kv").Op("*").Id(memoryType)) }
+	code.Func().Add(memRecv()).Id("Get").Params(jen.Id("key").Add(bytes())).Params(bytes(), jen.Bool()).Block(
+		jen.Id("kv").Dot("lock").Dot("RLock").Call(),
+		jen.Defer().Id("kv").Dot("lock").Dot("RUnlock").Call(),
+		jen.List(jen.Id("value"), jen.Id("ok")).Op(":=").Id("kv").Dot("values").Index(jen.String().Call(jen.Id("key"))),
+		jen.Return(jen.Id("value"), jen.Id("ok")),
+	).Line()
+	code.Func().Add(memRecv()).Id("Put").Params(withContext(proj, jen.Id("key"), jen.Id("value").Add(bytes()))...).Add(storageError(proj)).Block(
+		jen.Id("kv").Dot("lock").Dot("Lock").Call(),
+		jen.Defer().Id("kv").Dot("lock").Dot("Unlock").Call(),
+		jen.Id("name").Op(":=").String().Call(jen.Id("key")),
+		jen.If(jen.List(jen.Id("_"), jen.Id("exists")).Op(":=").Id("kv").Dot("values").Index(jen.Id("name")), jen.Op("!").Id("exists")).Block(
+			jen.Id("i").Op(":=").Qual("sort", "SearchStrings").Call(jen.Id("kv").Dot("keys"), jen.Id("name")),
+			jen.Id("kv").Dot("keys").Op("=").Append(jen.Id("kv").Dot("keys"), jen.Lit("")),
+			jen.Copy(jen.Id("kv").Dot("keys").Index(jen.Id("i").Op("+").Lit(1).Op(":")), jen.Id("kv").Dot("keys").Index(jen.Id("i").Op(":"))),
+			jen.Id("kv").Dot("keys").Index(jen.Id("i")).Op("=").Id("name"),
+		),
+		jen.Id("kv").Dot("values").Index(jen.Id("name")).Op("=").Append(bytes().Call(jen.Nil()), jen.Id("value").Op("...")),
+		result,
+	).Line()
+	code.Func().Add(memRecv()).Id("Delete").Params(withContext(proj, jen.Id("key").Add(bytes()))...).Add(storageError(proj)).Block(
+		jen.Id("kv").Dot("lock").Dot("Lock").Call(),
+		jen.Defer().Id("kv").Dot("lock").Dot("Unlock").Call(),
+		jen.Id("name").Op(":=").String().Call(jen.Id("key")),
+		jen.If(jen.List(jen.Id("_"), jen.Id("exists")).Op(":=").Id("kv").Dot("values").Index(jen.Id("name")), jen.Op("!").Id("exists")).Block(
+			done,
+		),
+		jen.Id("i").Op(":=").Qual("sort", "SearchStrings").Call(jen.Id("kv").Dot("keys"), jen.Id("name")),
+		jen.Id("kv").Dot("keys").Op("=").Append(jen.Id("kv").Dot("keys").Index(jen.Op(":").Id("i")), jen.Id("kv").Dot("keys").Index(jen.Id("i").Op("+").Lit(1).Op(":")).Op("...")),
+		jen.Delete(jen.Id("kv").Dot("values"), jen.Id("name")),
+		result,
+	).Line()
+	// matched values are copied, so iterator could write to the engine
+	code.Func().Add(memRecv()).Id("Scan").Params(jen.Id("prefix").Add(bytes()), jen.Id("iterator").Add(scanIterator.Clone())).Block(
+		jen.Id("kv").Dot("lock").Dot("RLock").Call(),
+		jen.Var().Id("keys").Index().String(),
+		jen.Var().Id("values").Index().Index().Byte(),
+		jen.For(
+			jen.Id("i").Op(":=").Qual("sort", "SearchStrings").Call(jen.Id("kv").Dot("keys"), jen.String().Call(jen.Id("prefix"))),
+			jen.Id("i").Op("<").Len(jen.Id("kv").Dot("keys")).Op("&&").Qual("strings", "HasPrefix").Call(jen.Id("kv").Dot("keys").Index(jen.Id("i")), jen.String().Call(jen.Id("prefix"))),
+			jen.Id("i").Op("++"),
+		).Block(
+			jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("kv").Dot("keys").Index(jen.Id("i"))),
+			jen.Id("values").Op("=").Append(jen.Id("values"), jen.Id("kv").Dot("values").Index(jen.Id("kv").Dot("keys").Index(jen.Id("i")))),
+		),
+		jen.Id("kv").Dot("lock").Dot("RUnlock").Call(),
+		jen.For(jen.List(jen.Id("i"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+			jen.If(jen.Op("!").Id("iterator").Call(bytes().Call(jen.Id("key")), jen.Id("values").Index(jen.Id("i")))).Block(jen.Return()),
+		),
+	).Line()
+
+	for _, model := range proj.Models {
+		code.Add(generateKVKey(model))
+	}
+
+	// storage of all models
+	recv := func() *jen.Statement { return jen.Params(jen.Id("storage").Op("*").Id(objName)) }
+	storages := "storages of all models"
+	if proj.Transactional {
+		storages = "transactional storage"
+	}
+	codecErrors := ". Errors of codec are panics"
+	if proj.Errors {
+		codecErrors = ". Errors of encoding are returned, errors of decoding are panics"
+	}
+	code.Comment(objName + " keeps items in KV engine: keys are name of model and order preserving encoding of item key, values are encoded by codec").Line()
+	code.Type().Id(objName).Struct(
+		jen.Id("kv").Id(kvType),
+		jen.Id("codec").Id(codecType),
+		jen.Id("project").Id(proj.Name+"Reader"),
+	).Line()
+	code.Comment("New" + objName + " creates " + storages + " over KV engine. Nil codec means " + binaryCodec + codecErrors).Line()
+	code.Func().Id("New"+objName).Params(jen.Id("kv").Id(kvType), jen.Id("codec").Id(codecType)).Op("*").Id(objName).Block(
+		jen.If(jen.Id("codec").Op("==").Nil()).Block(
+			jen.Id("codec").Op("=").Id(binaryCodec).Values(),
+		),
+		jen.Return().Op("&").Id(objName).Values(jen.Id("kv").Op(":").Id("kv"), jen.Id("codec").Op(":").Id("codec")),
+	).Line()
+	generateStorageLinkMethod(code, objName, proj)
+	code.Func().Add(recv()).Id("put").Params(withContext(proj, jen.Id("key").Add(bytes()), jen.Id("item").Interface())...).Add(storageError(proj)).BlockFunc(func(putFunc *jen.Group) {
+		putFunc.List(jen.Id("data"), jen.Err()).Op(":=").Id("storage").Dot("codec").Dot("Marshal").Call(jen.Id("item"))
+		if proj.Errors {
+			putFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+			putFunc.Return().Id("storage").Dot("kv").Dot("Put").Call(jen.Id("ctx"), jen.Id("key"), jen.Id("data"))
+			return
+		}
+		putFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err()))
+		putFunc.Id("storage").Dot("kv").Dot("Put").Call(jen.Id("key"), jen.Id("data"))
+	}).Line()
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		decode := func(group *jen.Group, data jen.Code) {
+			group.Id("item").Op(":=").Op("&").Id(model.Name).Values(jen.Id("_project").Op(":").Id("storage").Dot("project"))
+			group.If(jen.Err().Op(":=").Id("storage").Dot("codec").Dot("Unmarshal").Call(data, jen.Id("item")), jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err()))
+		}
+		if !proj.Transactional {
+			for _, action := range []string{"Put", "Update"} {
+				code.Func().Add(recv()).Id(action + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType.Clone()), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).Block(
+					kvWrite(proj, jen.Id("storage").Dot("put").Call(contextArg(proj, jen.Id(kvKeyFunc(model)).Call(jen.Id(keyName)), jen.Id("item"))...)),
+				).Line()
+			}
+			code.Func().Add(recv()).Id("Delete" + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType.Clone()))...).Add(storageError(proj)).Block(
+				kvWrite(proj, jen.Id("storage").Dot("kv").Dot("Delete").Call(contextArg(proj, jen.Id(kvKeyFunc(model)).Call(jen.Id(keyName)))...)),
+			).Line()
+		}
+		code.Func().Add(recv()).Id("Get" + model.Name).Params(jen.Id(keyName).Add(keyType.Clone())).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
+			getFunc.List(jen.Id("data"), jen.Id("ok")).Op(":=").Id("storage").Dot("kv").Dot("Get").Call(jen.Id(kvKeyFunc(model)).Call(jen.Id(keyName)))
+			getFunc.If(jen.Op("!").Id("ok")).Block(jen.Return().Nil())
+			decode(getFunc, jen.Id("data"))
+			getFunc.Return().Id("item")
+		}).Line()
+		code.Func().Add(recv()).Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).Block(
+			jen.Id("storage").Dot("kv").Dot("Scan").Call(jen.Index().Byte().Call(jen.Lit(kvPrefix(model))), jen.Func().Params(jen.Id("key"), jen.Id("data").Index().Byte()).Bool().BlockFunc(func(scanFunc *jen.Group) {
+				decode(scanFunc, jen.Id("data"))
+				scanFunc.Return().Id("iterator").Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))
+			})),
+		).Line()
+		generateStorageIter(code, objName, model)
+	}
+	if proj.Transactional {
+		// batch is written entity by entity: atomicity is up to KV engine
+		// in errors mode the first failed entity stops the batch
+		code.Func().Add(recv()).Id("Apply").Params(withContext(proj, jen.Id("batch").Index().Id(proj.Name+"LogEntity"))...).Add(storageError(proj)).BlockFunc(func(batchFunc *jen.Group) {
+			batchFunc.For(jen.List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch")).BlockFunc(func(batchItem *jen.Group) {
+				for _, model := range proj.Models {
+					entity := jen.Id("tx").Dot(model.Name)
+					batchItem.If(entity.Clone().Op("!=").Nil()).Block(
+						jen.Switch(entity.Clone().Dot("Action")).Block(
+							jen.Case(jen.Id(proj.Name+"ActionInsert"), jen.Id(proj.Name+"ActionUpdate")).BlockFunc(func(operation *jen.Group) {
+								generateStorageWrite(operation, proj, jen.Id("storage").Dot("put").Call(contextArg(proj, jen.Id(kvKeyFunc(model)).Call(entity.Clone().Dot("Item").Dot(model.Indexed)), jen.Op("&").Add(entity.Clone()).Dot("Item"))...))
+							}),
+							jen.Case(jen.Id(proj.Name+"ActionDelete")).BlockFunc(func(operation *jen.Group) {
+								generateStorageWrite(operation, proj, jen.Id("storage").Dot("kv").Dot("Delete").Call(contextArg(proj, jen.Id(kvKeyFunc(model)).Call(entity.Clone().Dot(model.Indexed)))...))
+							}),
+						),
+					)
+				}
+			})
+			generateStorageResult(batchFunc, proj)
+		}).Line()
+	}
+	return code
+}
+
+// kvWrite is write of KV storage: in errors mode error of engine or codec is returned
+func kvWrite(proj *memdata.Project, call *jen.Statement) jen.Code {
+	if proj.Errors {
+		return jen.Return().Add(call)
+	}
+	return call
+}
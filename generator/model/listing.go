@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// generateListingInterface adds listing, counting, existence checks and sequences of models to the reader
+func generateListingInterface(iface *jen.Group, proj *memdata.Project) {
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		iface.Id("All" + model.Name + "s").Params().Index().Op("*").Id(model.Name)
+		iface.Id("Count" + model.Name).Params().Int()
+		iface.Id("Has" + model.Name).Params(jen.Id(keyName).Add(keyType.Clone())).Bool()
+		iface.Id("Iter" + model.Name).Params().Add(seqType(model))
+		if isOrderedType(model.FieldType(model.Indexed)) {
+			iface.Id("List"+model.Name).Params(jen.Id("cursor").Op("*").Add(keyType.Clone()), jen.Id("limit").Int()).Params(jen.Index().Op("*").Id(model.Name), jen.Op("*").Add(keyType.Clone()))
+		}
+	}
+}
+
+// generateListing implements listing of visible items: in order of keys for ordered keys, in order of storage for
+// others
+func generateListing(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	receiver := func() *jen.Statement { return jen.Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)) }
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		ordered := isOrderedType(model.FieldType(model.Indexed))
+		code.Func().Add(receiver()).Id("All" + model.Name + "s").Params().Index().Op("*").Id(model.Name).BlockFunc(func(allFunc *jen.Group) {
+			generateReadLock(allFunc, proj)
+			if !ordered {
+				generateVisibleItems(allFunc, model, jen.Id("project").Dot("keys"+model.Name).Call())
+				return
+			}
+			allFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+			allFunc.Id("project").Dot("ascend"+model.Name).Call(jen.Nil(), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+				jen.Return().True(),
+			))
+			allFunc.Return().Id("items")
+		}).Line()
+		// items are collected under the lock, so the loop body could use the project
+		code.Func().Add(receiver()).Id("Iter" + model.Name).Params().Add(seqType(model)).Block(
+			jen.Return().Func().Params(jen.Id("yield").Func().Params(keyType.Clone(), jen.Op("*").Id(model.Name)).Bool()).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("project").Dot("All" + model.Name + "s").Call()).Block(
+					jen.If(jen.Op("!").Id("yield").Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))).Block(jen.Return()),
+				),
+			),
+		).Line()
+		code.Func().Add(receiver()).Id("Count" + model.Name).Params().Int().BlockFunc(func(countFunc *jen.Group) {
+			generateReadLock(countFunc, proj)
+			countFunc.Return().Len(jen.Id("project").Dot("keys" + model.Name).Call())
+		}).Line()
+		code.Func().Add(receiver()).Id("Has" + model.Name).Params(jen.Id(keyName).Add(keyType.Clone())).Bool().BlockFunc(func(hasFunc *jen.Group) {
+			generateReadLock(hasFunc, proj)
+			hasFunc.Return().Add(visibleItem(model, jen.Id(keyName))).Op("!=").Nil()
+		}).Line()
+		if !ordered {
+			continue
+		}
+		// page of items after the cursor (nil is the start) and cursor of the next page (nil after the last page)
+		code.Func().Add(receiver()).Id("List"+model.Name).Params(jen.Id("cursor").Op("*").Add(keyType.Clone()), jen.Id("limit").Int()).Params(jen.Index().Op("*").Id(model.Name), jen.Op("*").Add(keyType.Clone())).BlockFunc(func(listFunc *jen.Group) {
+			generateReadLock(listFunc, proj)
+			listFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+			listFunc.Var().Id("next").Op("*").Add(keyType.Clone())
+			listFunc.Id("project").Dot("ascend"+model.Name).Call(jen.Id("cursor"), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.If(jen.Id("cursor").Op("!=").Nil().Op("&&").Id("item").Dot(model.Indexed).Op("==").Op("*").Id("cursor")).Block(
+					jen.Return().True(),
+				),
+				jen.If(jen.Id("limit").Op(">").Lit(0).Op("&&").Len(jen.Id("items")).Op("==").Id("limit")).Block(
+					jen.Id("last").Op(":=").Id("items").Index(jen.Len(jen.Id("items")).Op("-").Lit(1)).Dot(model.Indexed),
+					jen.Id("next").Op("=").Op("&").Id("last"),
+					jen.Return().False(),
+				),
+				jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+				jen.Return().True(),
+			))
+			listFunc.Return(jen.Id("items"), jen.Id("next"))
+		}).Line()
+	}
+	return code
+}
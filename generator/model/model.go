@@ -6,9 +6,9 @@ import (
 )
 
 func GenerateModel(model *memdata.Model) *jen.Statement {
-	code := generateModelStruct(model).Add(generateModelFuncs(model))
-	if model.Project.Transactional {
-		code.Add(generateModelTransactionEntity(model))
+	code := generateModelStruct(model).Add(generateModelFuncs(model)).Add(generateValidate(model)).Add(generateModelBinary(model))
+	if model.Project.Transactional || model.Project.Events {
+		code.Add(generateModelTransactionEntity(model)).Add(generateLogEntityBinary(model))
 	}
 	return code
 }
@@ -16,7 +16,7 @@ func GenerateModel(model *memdata.Model) *jen.Statement {
 func generateModelStruct(model *memdata.Model) *jen.Statement {
 	return jen.Type().Id(model.Name).StructFunc(func(st *jen.Group) {
 		for name, fieldType := range model.Fields {
-			st.Id(name).Add(model.Project.Qual(fieldType))
+			st.Id(name).Add(typeCode(model.Project, fieldType))
 		}
 		// to-many array links
 		for fieldName, modelRef := range model.HasMany {
@@ -66,6 +66,12 @@ func generateModelFuncs(model *memdata.Model) *jen.Statement {
 			manyRef.Return().Id("items")
 		}).Line()
 	}
+	// add access to referrers (back references)
+	for _, index := range modelBackReferences(model) {
+		fns = fns.Func().Parens(jen.Id("model").Op("*").Id(model.Name)).Id(index.Model.Name + "sBy" + index.Name).Params().Index().Op("*").Id(index.Model.Name).BlockFunc(func(backRef *jen.Group) {
+			backRef.Return().Id("model").Dot("_project").Dot(index.Model.Name + "By" + index.Name).Call(jen.Id("model").Dot(model.Indexed))
+		}).Line()
+	}
 	return fns
 }
 
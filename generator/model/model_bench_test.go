@@ -52,7 +52,7 @@ type Data interface {
 
 type DataTxStorage interface {
 	GetUser(id int64) *User
-	IterateUser(iterator func(id int64, item *User))
+	IterateUser(iterator func(id int64, item *User) bool)
 	Apply(batch []DataLogEntity)
 }
 
@@ -66,10 +66,11 @@ type implData struct {
 func NewData(storage DataTxStorage) Data {
 	// restore auto-sequence for User.Id
 	var maxIdOfUser int64
-	storage.IterateUser(func(id int64, item *User) {
+	storage.IterateUser(func(id int64, item *User) bool {
 		if id > maxIdOfUser {
 			maxIdOfUser = id
 		}
+		return true
 	})
 	return &implData{storage: storage, sequenceUserId: maxIdOfUser}
 }
@@ -128,9 +129,11 @@ func NewMapDataStorage() DataTxStorage {
 func (storage *memDataMapStorage) GetUser(id int64) *User {
 	return storage.User[id]
 }
-func (storage *memDataMapStorage) IterateUser(iterator func(id int64, item *User)) {
+func (storage *memDataMapStorage) IterateUser(iterator func(id int64, item *User) bool) {
 	for key, item := range storage.User {
-		iterator(key, item)
+		if !iterator(key, item) {
+			return
+		}
 	}
 }
 func (storage *memDataMapStorage) Apply(batch []DataLogEntity) {
@@ -164,16 +167,25 @@ type treeAdapter struct {
 	tree *Tree
 }
 
-func (*treeAdapter) IterateUser(iterator func(id int64, item *User)) {
+func (b *treeAdapter) IterateUser(iterator func(id int64, item *User) bool) {
+	it := b.tree.Iterator()
+	for it.Next() {
+		if !iterator(it.Key(), it.Value()) {
+			return
+		}
+	}
 }
-func (*treeAdapter) GetUser(id int64) *User {
-	panic("implement me")
+func (b *treeAdapter) GetUser(id int64) *User {
+	item, _ := b.tree.Get(id)
+	return item
 }
 func (b *treeAdapter) Apply(batch []DataLogEntity) {
 	for _, item := range batch {
 		switch item.User.Action {
-		case DataActionInsert:
+		case DataActionInsert, DataActionUpdate:
 			b.tree.Put(item.User.Id, &item.User.Item)
+		case DataActionDelete:
+			b.tree.Remove(item.User.Id)
 		}
 	}
 }
@@ -182,18 +194,27 @@ type btreeAdapter struct {
 	tree *BTree
 }
 
-func (*btreeAdapter) GetUser(id int64) *User {
-	panic("implement me")
+func (b *btreeAdapter) GetUser(id int64) *User {
+	item, _ := b.tree.Get(id)
+	return item
 }
 
-func (*btreeAdapter) IterateUser(iterator func(id int64, item *User)) {
+func (b *btreeAdapter) IterateUser(iterator func(id int64, item *User) bool) {
+	it := b.tree.Iterator()
+	for it.Next() {
+		if !iterator(it.Key(), it.Value()) {
+			return
+		}
+	}
 }
 
 func (b *btreeAdapter) Apply(batch []DataLogEntity) {
 	for _, item := range batch {
 		switch item.User.Action {
-		case DataActionInsert:
+		case DataActionInsert, DataActionUpdate:
 			b.tree.Put(item.User.Id, &item.User.Item)
+		case DataActionDelete:
+			b.tree.Remove(item.User.Id)
 		}
 	}
 }
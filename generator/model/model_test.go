@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"github.com/dave/jennifer/jen"
 	"github.com/reddec/memdata"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -23,3 +27,243 @@ func TestGenerateModel(t *testing.T) {
 	os.Stderr.Write(bts.Bytes())
 	os.Stderr.Sync()
 }
+
+func TestGenerateIndexes(t *testing.T) {
+	testGenerated(t, "testdata/indexes")
+	testGenerated(t, "testdata/indexes_tx")
+}
+
+func TestGenerateUnique(t *testing.T) {
+	testGenerated(t, "testdata/unique")
+	testGenerated(t, "testdata/unique_tx")
+}
+
+// testGenerated renders project.yaml from the directory and runs tests from the same directory against generated code.
+// Shared packages (directories, ex: fake drivers) are copied next to the generated code.
+func testGenerated(t *testing.T, dir string, shared ...string) {
+	if testing.Short() {
+		t.Skip("compilation of generated code skipped in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+	project, err := memdata.ReadFile(filepath.Join(dir, "project.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpDir, err := ioutil.TempDir("", "memdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	file := jen.NewFile(project.Package)
+	file.Add(Generate(project))
+	bts := &bytes.Buffer{}
+	err = file.Render(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "generated.go"), bts.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/"+project.Package+"\n\ngo 1.23\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range tests {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(tmpDir, filepath.Base(file)), data, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// packages used by tests (ex: imitation of other generators) are copied from subdirectories
+	packages, err := filepath.Glob(filepath.Join(dir, "*", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range shared {
+		files, err := filepath.Glob(filepath.Join(pkg, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, files...)
+	}
+	for _, file := range packages {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkgDir := filepath.Join(tmpDir, filepath.Base(filepath.Dir(file)))
+		if err = os.MkdirAll(pkgDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(pkgDir, filepath.Base(file)), data, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(goBin, "vet", ".")
+	cmd.Dir = tmpDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%s: generated code is not valid: %v\n%s", dir, err, out)
+	}
+	cmd = exec.Command(goBin, "test", "-count=1", ".")
+	cmd.Dir = tmpDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%s: tests failed: %v\n%s", dir, err, out)
+	}
+}
+
+func TestGenerateReferences(t *testing.T) {
+	testGenerated(t, "testdata/refs")
+	testGenerated(t, "testdata/refs_tx")
+}
+
+func TestGenerateBackends(t *testing.T) {
+	testGenerated(t, "testdata/rbtree")
+	testGenerated(t, "testdata/btree_tx")
+}
+
+func TestGenerateOrdered(t *testing.T) {
+	testGenerated(t, "testdata/ordered")
+	testGenerated(t, "testdata/ordered_tx")
+}
+
+func TestGenerateSnapshot(t *testing.T) {
+	testGenerated(t, "testdata/snapshot")
+	testGenerated(t, "testdata/snapshot_tx")
+}
+
+func TestGenerateWAL(t *testing.T) {
+	testGenerated(t, "testdata/wal")
+}
+
+func TestGenerateOverlay(t *testing.T) {
+	testGenerated(t, "testdata/overlay_tx")
+}
+
+func TestGenerateMVCC(t *testing.T) {
+	testGenerated(t, "testdata/mvcc_tx")
+}
+
+func TestGenerateSavepoints(t *testing.T) {
+	testGenerated(t, "testdata/savepoint_tx")
+}
+
+func TestGenerateEvents(t *testing.T) {
+	testGenerated(t, "testdata/events")
+	testGenerated(t, "testdata/events_tx")
+}
+
+func TestGenerateVersion(t *testing.T) {
+	testGenerated(t, "testdata/version")
+	testGenerated(t, "testdata/version_tx")
+}
+
+func TestGenerateValidate(t *testing.T) {
+	testGenerated(t, "testdata/validate")
+}
+
+func TestGenerateDefaults(t *testing.T) {
+	testGenerated(t, "testdata/defaults")
+	testGenerated(t, "testdata/defaults_tx")
+}
+
+func TestGenerateBinary(t *testing.T) {
+	testGenerated(t, "testdata/binary")
+}
+
+func TestGenerateHTTP(t *testing.T) {
+	testGenerated(t, "testdata/http")
+	testGenerated(t, "testdata/http_tx")
+}
+
+func TestGenerateProto(t *testing.T) {
+	testGenerated(t, "testdata/proto")
+	project, err := memdata.ReadFile("testdata/proto/project.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ioutil.ReadFile("testdata/proto/data.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated := GenerateProto(project); generated != string(expected) {
+		t.Errorf("generated proto file differs from testdata/proto/data.proto:\n%s", generated)
+	}
+}
+
+func TestProtoNumbers(t *testing.T) {
+	project, err := memdata.ReadFile("testdata/proto/project.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := project.Model("User")
+	user.Fields["Bio"] = "string"
+	user.ProtoNumbers["Bio"] = 10
+	generated := GenerateProto(project)
+	// numbers of fields are kept after adding of field
+	for _, line := range []string{"  bool active = 1;", "  string name = 7;", "  repeated string tags = 9;", "  string bio = 10;"} {
+		if !strings.Contains(generated, line+"\n") {
+			t.Errorf("generated proto file has no %q:\n%s", line, generated)
+		}
+	}
+}
+
+func TestGenerateGraphQL(t *testing.T) {
+	testGenerated(t, "testdata/graphql")
+	testGenerated(t, "testdata/graphql_tx")
+	project, err := memdata.ReadFile("testdata/graphql/project.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ioutil.ReadFile("testdata/graphql/schema.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated := GenerateGraphQL(project); generated != string(expected) {
+		t.Errorf("generated schema differs from testdata/graphql/schema.graphql:\n%s", generated)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	testGenerated(t, "testdata/errors")
+	testGenerated(t, "testdata/errors_tx")
+}
+
+func TestGenerateKV(t *testing.T) {
+	testGenerated(t, "testdata/kv")
+	testGenerated(t, "testdata/kv_tx")
+}
+
+func TestGenerateQuery(t *testing.T) {
+	testGenerated(t, "testdata/query")
+	testGenerated(t, "testdata/query_tx")
+}
+
+func TestGenerateSQL(t *testing.T) {
+	testGenerated(t, "testdata/sql", "testdata/fakesql")
+	testGenerated(t, "testdata/sql_tx", "testdata/fakesql")
+	project, err := memdata.ReadFile("testdata/sql/project.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ioutil.ReadFile("testdata/sql/schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated := GenerateSQLSchema(project); generated != string(expected) {
+		t.Errorf("generated schema differs from testdata/sql/schema.sql:\n%s", generated)
+	}
+}
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// Multi-version mode: read transaction is a view at commit version. View shares committed storage and indexes with the
+// project and keeps previous state of items changed after the version as pending (undo) changes, so readers see
+// committed state of the version through the same overlay as write transactions see their own changes.
+
+func versionsType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Versions"
+}
+
+// generateApplyBatch applies batch to committed state. In multi-version mode previous state of changed items is
+// preserved in views of readers. In errors mode error of storage is returned from the enclosing function before
+// indexes are changed (after fail statements).
+func generateApplyBatch(group *jen.Group, proj *memdata.Project, batch jen.Code, fail ...jen.Code) {
+	if proj.MVCC {
+		group.Id("project").Dot("_commit").Dot("Lock").Call()
+		group.Id("project").Dot("preserveVersions").Call(batch)
+	}
+	apply := jen.Id("project").Dot("storage").Dot("Apply").Call(contextArg(proj, batch)...)
+	if proj.Errors {
+		group.If(jen.Err().Op(":=").Add(apply), jen.Err().Op("!=").Nil()).BlockFunc(func(failFunc *jen.Group) {
+			if proj.MVCC {
+				failFunc.Id("project").Dot("_commit").Dot("Unlock").Call()
+			}
+			failFunc.Add(fail...)
+			failFunc.Return().Err()
+		})
+	} else {
+		group.Add(apply)
+	}
+	if hasIndexes(proj) {
+		group.Id("project").Dot("applyIndexes").Call(batch)
+	}
+	if proj.MVCC {
+		group.Id("project").Dot("_commit").Dot("Unlock").Call()
+	}
+}
+
+// generateVersionFields adds shared state of versions to the project struct
+func generateVersionFields(st *jen.Group, proj *memdata.Project) {
+	// lock of committed state: exclusive for commit, shared for reads
+	st.Id("_commit").Op("*").Qual("sync", "RWMutex")
+	st.Id("_versions").Op("*").Id(versionsType(proj))
+	// version and readers of the view
+	st.Id("_version").Int64()
+	st.Id("_refs").Int()
+}
+
+// generateVersionValues initializes shared state of versions in the constructor
+func generateVersionValues(fv *jen.Group, proj *memdata.Project) {
+	fv.Id("_commit").Op(":").Op("&").Qual("sync", "RWMutex").Values()
+	fv.Id("_versions").Op(":").Op("&").Id(versionsType(proj)).Values(jen.Id("views").Op(":").Make(jen.Map(jen.Int64()).Op("*").Id("impl" + proj.Name)))
+}
+
+// generateVersions defines registry of views and read transactions over views
+func generateVersions(proj *memdata.Project) jen.Code {
+	typeName := versionsType(proj)
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)) }
+	code := jen.Comment(typeName + " is registry of views (by commit version) used by readers").Line()
+	code.Type().Id(typeName).Struct(
+		jen.Id("lock").Qual("sync", "Mutex"),
+		jen.Id("version").Int64(),
+		jen.Id("views").Map(jen.Int64()).Op("*").Id("impl"+proj.Name),
+	).Line()
+	// read lock: reuse or create view at current version
+	code.Func().Add(recv()).Id("ReadLock").Params().Id(proj.Name + "ReaderTx").BlockFunc(func(lockFunc *jen.Group) {
+		lockFunc.Id("versions").Op(":=").Id("project").Dot("_versions")
+		lockFunc.Id("versions").Dot("lock").Dot("Lock").Call()
+		lockFunc.Defer().Id("versions").Dot("lock").Dot("Unlock").Call()
+		lockFunc.List(jen.Id("view"), jen.Id("ok")).Op(":=").Id("versions").Dot("views").Index(jen.Id("versions").Dot("version"))
+		lockFunc.If(jen.Op("!").Id("ok")).BlockFunc(func(create *jen.Group) {
+			create.Id("view").Op("=").Op("&").Id("impl" + proj.Name).ValuesFunc(func(fv *jen.Group) {
+				fv.Id("storage").Op(":").Id("project").Dot("storage")
+				for _, model := range proj.Models {
+					for _, index := range modelIndexes(model) {
+						fv.Id(index.FieldName()).Op(":").Id("project").Dot(index.FieldName())
+					}
+				}
+				fv.Id("_commit").Op(":").Id("project").Dot("_commit")
+				fv.Id("_versions").Op(":").Id("versions")
+				fv.Id("_version").Op(":").Id("versions").Dot("version")
+			})
+			create.Id("view").Dot("resetPending").Call()
+			create.Id("versions").Dot("views").Index(jen.Id("view").Dot("_version")).Op("=").Id("view")
+		})
+		lockFunc.Id("view").Dot("_refs").Op("++")
+		lockFunc.Return().Id("view")
+	}).Line()
+	// read unlock: the view is dropped after the last reader
+	code.Func().Add(recv()).Id("ReadUnlock").Params().BlockFunc(func(unlockFunc *jen.Group) {
+		unlockFunc.Id("versions").Op(":=").Id("project").Dot("_versions")
+		unlockFunc.Id("versions").Dot("lock").Dot("Lock").Call()
+		unlockFunc.Defer().Id("versions").Dot("lock").Dot("Unlock").Call()
+		unlockFunc.Id("project").Dot("_refs").Op("--")
+		unlockFunc.If(jen.Id("project").Dot("_refs").Op("==").Lit(0)).Block(
+			jen.Delete(jen.Id("versions").Dot("views"), jen.Id("project").Dot("_version")),
+		)
+	}).Line()
+	// preserve state of all views before batch applied and start next version
+	code.Func().Add(recv()).Id("preserveVersions").Params(jen.Id("batch").Index().Id(proj.Name + "LogEntity")).BlockFunc(func(preserveFunc *jen.Group) {
+		preserveFunc.If(jen.Len(jen.Id("batch")).Op("==").Lit(0)).Block(jen.Return())
+		preserveFunc.Id("versions").Op(":=").Id("project").Dot("_versions")
+		preserveFunc.Id("versions").Dot("lock").Dot("Lock").Call()
+		preserveFunc.Defer().Id("versions").Dot("lock").Dot("Unlock").Call()
+		preserveFunc.For(jen.List(jen.Id("_"), jen.Id("view")).Op(":=").Range().Id("versions").Dot("views")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch")).BlockFunc(func(batchItem *jen.Group) {
+				for _, model := range proj.Models {
+					batchItem.If(jen.Id("tx").Dot(model.Name).Op("!=").Nil()).Block(
+						jen.Id("view").Dot("preserve" + model.Name).Call(jen.Id("tx").Dot(model.Name).Dot(model.Indexed)),
+					)
+				}
+			}),
+		)
+		preserveFunc.Id("versions").Dot("version").Op("++")
+	}).Line()
+	// preserve committed state of item in the view (only first change after version of view)
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		code.Func().Add(recv()).Id("preserve" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).BlockFunc(func(preserveFunc *jen.Group) {
+			preserveFunc.If(jen.List(jen.Id("_"), jen.Id("preserved")).Op(":=").Id("project").Dot("_pending"+model.Name).Index(jen.Id(keyName)), jen.Id("preserved")).Block(jen.Return())
+			preserveFunc.Id("entity").Op(":=").Op("&").Id(model.Name+"LogEntity").Values(
+				jen.Id(model.Indexed).Op(":").Id(keyName),
+				jen.Id("Action").Op(":").Id(proj.Name+"ActionDelete"),
+			)
+			preserveFunc.If(jen.Id("item").Op(":=").Id("project").Dot("storage").Dot("Get"+model.Name).Call(jen.Id(keyName)), jen.Id("item").Op("!=").Nil()).Block(
+				jen.Id("entity").Dot("Item").Op("=").Op("*").Id("item"),
+				jen.Id("entity").Dot("Action").Op("=").Id(proj.Name+"ActionUpdate"),
+			)
+			preserveFunc.Id("project").Dot("track" + model.Name).Call(jen.Id("entity"))
+		}).Line()
+	}
+	return code
+}
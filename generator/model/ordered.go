@@ -0,0 +1,467 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"github.com/reddec/memdata/generator/tree"
+)
+
+func isOrderedType(typeName string) bool {
+	return memdata.IsNumType(typeName) || typeName == "string"
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// visibleItem returns item by key visible to the reader: in transactional mode committed state is overlapped by
+// pending changes of the transaction
+func visibleItem(model *memdata.Model, key jen.Code) jen.Code {
+	if model.Project.Transactional {
+		return jen.Id("project").Dot("get" + model.Name).Call(key)
+	}
+	return jen.Id("project").Dot("index" + model.Name + "By" + model.Indexed).Dot("Get" + model.Name).Call(key)
+}
+
+// committedStorage returns storage of model
+func committedStorage(model *memdata.Model) jen.Code {
+	if model.Project.Transactional {
+		return jen.Id("project").Dot("storage")
+	}
+	return jen.Id("project").Dot("index" + model.Name + "By" + model.Indexed)
+}
+
+// generateVisibleItems returns from the enclosing function visible items by keys
+func generateVisibleItems(group *jen.Group, model *memdata.Model, keys jen.Code) {
+	group.Id("keys").Op(":=").Add(keys)
+	group.Id("items").Op(":=").Make(jen.Index().Op("*").Id(model.Name), jen.Lit(0), jen.Len(jen.Id("keys")))
+	group.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+		jen.Id("items").Op("=").Append(jen.Id("items"), visibleItem(model, jen.Id("key"))),
+	)
+	group.Return().Id("items")
+}
+
+// generateOrderedStorageInterfaces defines optional interfaces of storages with ordered keys
+func generateOrderedStorageInterfaces(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		if !isOrderedType(model.FieldType(model.Indexed)) {
+			continue
+		}
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		code.Comment(model.Name + "OrderedStorage is implemented by storage with ordered keys (tree storages) to avoid full scan in range").Line()
+		code.Comment("queries. Other storages are scanned and sorted.").Line()
+		code.Type().Id(model.Name + "OrderedStorage").InterfaceFunc(func(iface *jen.Group) {
+			// AscendModel (from) callback(id, value) -> continue: keys greater than or equal to from
+			iface.Id("Ascend"+model.Name).Params(jen.Id("from").Add(keyType), jen.Id("iterator").Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool())
+			// DescendModel (from) callback(id, value) -> continue: keys less than or equal to from
+			iface.Id("Descend"+model.Name).Params(jen.Id("from").Add(keyType), jen.Id("iterator").Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool())
+			iface.Id("First" + model.Name).Params().Op("*").Id(model.Name)
+			iface.Id("Last" + model.Name).Params().Op("*").Id(model.Name)
+		}).Line()
+	}
+	return code
+}
+
+// generateOrderedReaderInterface adds range queries by ordered keys and ordered secondary indexes to the reader
+func generateOrderedReaderInterface(iface *jen.Group, proj *memdata.Project) {
+	for _, model := range proj.Models {
+		if isOrderedType(model.FieldType(model.Indexed)) {
+			keyName := memdata.ToLowerCamel(model.Indexed)
+			keyType := jen.Id(model.FieldType(model.Indexed))
+			iface.Id(model.Name+"Range").Params(jen.List(jen.Id("from"), jen.Id("to")).Add(keyType), jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool())
+			iface.Id(model.Name + "First").Params().Op("*").Id(model.Name)
+			iface.Id(model.Name + "Last").Params().Op("*").Id(model.Name)
+			iface.Id(model.Name + "Floor").Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name)
+			iface.Id(model.Name + "Ceiling").Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name)
+		}
+		for _, index := range modelIndexes(model) {
+			if !index.Ordered {
+				continue
+			}
+			valueName := memdata.ToLowerCamel(index.Field)
+			valueType := jen.Id(index.Type)
+			iface.Id(model.Name+"RangeBy"+index.Name).Params(jen.List(jen.Id("from"), jen.Id("to")).Add(valueType), jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool())
+			iface.Id(model.Name + "FirstBy" + index.Name).Params().Index().Op("*").Id(model.Name)
+			iface.Id(model.Name + "LastBy" + index.Name).Params().Index().Op("*").Id(model.Name)
+			iface.Id(model.Name + "FloorBy" + index.Name).Params(jen.Id(valueName).Add(valueType)).Index().Op("*").Id(model.Name)
+			iface.Id(model.Name + "CeilingBy" + index.Name).Params(jen.Id(valueName).Add(valueType)).Index().Op("*").Id(model.Name)
+		}
+	}
+}
+
+// generateOrderedReader implements range queries. Items of ordered storage are merged with pending changes in order of
+// keys, other storages are scanned and sorted.
+func generateOrderedReader(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		receiver := jen.Id("project").Op("*").Id("impl" + proj.Name)
+		if isOrderedType(model.FieldType(model.Indexed)) {
+			keyName := memdata.ToLowerCamel(model.Indexed)
+			keyType := jen.Id(model.FieldType(model.Indexed))
+			// sorted keys of storage without order (and pending changes)
+			code.Func().Params(receiver.Clone()).Id("sorted"+model.Name+"Keys").Params().Index().Add(keyType).Block(
+				jen.Id("keys").Op(":=").Id("project").Dot("keys"+model.Name).Call(),
+				jen.Qual("sort", "Slice").Call(jen.Id("keys"), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+					jen.Return().Id("keys").Index(jen.Id("i")).Op("<").Id("keys").Index(jen.Id("j")),
+				)),
+				jen.Return().Id("keys"),
+			).Line()
+			generateOrderedWalks(code, model)
+			// first visible item of walk
+			first := func(group *jen.Group, walk string, from jen.Code) {
+				group.Var().Id("result").Op("*").Id(model.Name)
+				group.Id("project").Dot(walk+model.Name).Call(from, jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Id("result").Op("=").Id("item"),
+					jen.Return().False(),
+				))
+				group.Return().Id("result")
+			}
+			// range [from, to)
+			code.Func().Params(receiver.Clone()).Id(model.Name+"Range").Params(jen.List(jen.Id("from"), jen.Id("to")).Add(keyType), jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool()).BlockFunc(func(rangeFunc *jen.Group) {
+				generateUnlockedIteration(rangeFunc, model, func(collect *jen.Group) {
+					collect.Id("project").Dot("ascend"+model.Name).Call(jen.Op("&").Id("from"), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+						jen.If(jen.Id("item").Dot(model.Indexed).Op(">=").Id("to")).Block(jen.Return().False()),
+						jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+						jen.Return().True(),
+					))
+				})
+			}).Line()
+			// first and last
+			for _, edge := range []string{"First", "Last"} {
+				walk := "ascend"
+				if edge == "Last" {
+					walk = "descend"
+				}
+				code.Func().Params(receiver.Clone()).Id(model.Name + edge).Params().Op("*").Id(model.Name).BlockFunc(func(edgeFunc *jen.Group) {
+					generateReadLock(edgeFunc, proj)
+					first(edgeFunc, walk, jen.Nil())
+				}).Line()
+			}
+			// floor: the largest key less than or equal to the key
+			code.Func().Params(receiver.Clone()).Id(model.Name + "Floor").Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).BlockFunc(func(floorFunc *jen.Group) {
+				generateReadLock(floorFunc, proj)
+				first(floorFunc, "descend", jen.Op("&").Id(keyName))
+			}).Line()
+			// ceiling: the smallest key greater than or equal to the key
+			code.Func().Params(receiver.Clone()).Id(model.Name + "Ceiling").Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).BlockFunc(func(ceilingFunc *jen.Group) {
+				generateReadLock(ceilingFunc, proj)
+				first(ceilingFunc, "ascend", jen.Op("&").Id(keyName))
+			}).Line()
+		}
+		// ordered secondary indexes
+		for _, index := range modelIndexes(model) {
+			if !index.Ordered {
+				continue
+			}
+			valueName := memdata.ToLowerCamel(index.Field)
+			valueType := jen.Id(index.Type)
+			code.Func().Params(receiver.Clone()).Id(model.Name+"RangeBy"+index.Name).Params(jen.List(jen.Id("from"), jen.Id("to")).Add(valueType), jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool()).BlockFunc(func(rangeFunc *jen.Group) {
+				generateUnlockedIteration(rangeFunc, model, func(collect *jen.Group) {
+					collect.Id("project").Dot("range"+model.Name+"By"+index.Name).Call(jen.Id("from"), jen.Id("to"), jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+						jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+						jen.Return().True(),
+					))
+				})
+			}).Line()
+			// range of values without lock (used by queries)
+			code.Func().Params(receiver.Clone()).Id("range"+model.Name+"By"+index.Name).Params(jen.List(jen.Id("from"), jen.Id("to")).Add(valueType), jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool()).BlockFunc(func(rangeFunc *jen.Group) {
+				if !proj.Transactional {
+					rangeFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot(index.FieldName()).Dot("rangeKeys").Call(jen.Id("from"), jen.Id("to"))).Block(
+						jen.If(jen.Op("!").Id("iterator").Call(visibleItem(model, jen.Id("key")))).Block(jen.Return()),
+					)
+					return
+				}
+				// merge committed and pending items in order of values
+				rangeFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+				rangeFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot(index.FieldName()).Dot("rangeKeys").Call(jen.Id("from"), jen.Id("to"))).Block(
+					jen.If(jen.Id("project").Dot("committed" + model.Name).Call(jen.Id("key"))).Block(
+						jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("project").Dot("storage").Dot("Get"+model.Name).Call(jen.Id("key"))),
+					),
+				)
+				rangeFunc.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot(index.PendingName()).Dot("rangeKeys").Call(jen.Id("from"), jen.Id("to"))).Block(
+					jen.Id("items").Op("=").Append(jen.Id("items"), visibleItem(model, jen.Id("key"))),
+				)
+				rangeFunc.Qual("sort", "SliceStable").Call(jen.Id("items"), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+					jen.Return().Id("items").Index(jen.Id("i")).Dot(index.Field).Op("<").Id("items").Index(jen.Id("j")).Dot(index.Field),
+				))
+				rangeFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+					jen.If(jen.Op("!").Id("iterator").Call(jen.Id("item"))).Block(jen.Return()),
+				)
+			}).Line()
+			for _, edge := range []string{"First", "Last", "Floor", "Ceiling"} {
+				var params, args []jen.Code
+				if edge == "Floor" || edge == "Ceiling" {
+					params = []jen.Code{jen.Id(valueName).Add(valueType)}
+					args = []jen.Code{jen.Id(valueName)}
+				}
+				// the nearest pending value wins: less for walk forward, greater for walk backward
+				nearer := "<"
+				if edge == "Last" || edge == "Floor" {
+					nearer = ">"
+				}
+				method := memdata.ToLowerCamel(edge)
+				code.Func().Params(receiver.Clone()).Id(model.Name + edge + "By" + index.Name).Params(params...).Index().Op("*").Id(model.Name).BlockFunc(func(edgeFunc *jen.Group) {
+					generateReadLock(edgeFunc, proj)
+					if !proj.Transactional {
+						edgeFunc.List(jen.Id("_"), jen.Id("matched"), jen.Id("_")).Op(":=").Id("project").Dot(index.FieldName()).Dot(method).Call(append(args, jen.Nil())...)
+						generateVisibleItems(edgeFunc, model, jen.Id("matched"))
+						return
+					}
+					edgeFunc.List(jen.Id("value"), jen.Id("matched"), jen.Id("found")).Op(":=").Id("project").Dot(index.FieldName()).Dot(method).Call(append(args, jen.Id("project").Dot("committed"+model.Name))...)
+					edgeFunc.List(jen.Id("pendingValue"), jen.Id("pendingKeys"), jen.Id("pendingFound")).Op(":=").Id("project").Dot(index.PendingName()).Dot(method).Call(append(args, jen.Nil())...)
+					edgeFunc.If(jen.Id("pendingFound").Op("&&").Parens(jen.Op("!").Id("found").Op("||").Id("pendingValue").Op(nearer).Id("value"))).Block(
+						jen.Id("matched").Op("=").Id("pendingKeys"),
+					).Else().If(jen.Id("pendingFound").Op("&&").Id("pendingValue").Op("==").Id("value")).Block(
+						jen.Id("matched").Op("=").Append(jen.Id("matched"), jen.Id("pendingKeys").Op("...")),
+					)
+					generateVisibleItems(edgeFunc, model, jen.Id("matched"))
+				}).Line()
+			}
+		}
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		// committed state of item is not changed in the transaction
+		if proj.Transactional {
+			code.Func().Params(receiver.Clone()).Id("committed"+model.Name).Params(jen.Id(keyName).Add(keyType)).Bool().Block(
+				jen.List(jen.Id("_"), jen.Id("changed")).Op(":=").Id("project").Dot("_pending"+model.Name).Index(jen.Id(keyName)),
+				jen.Return().Op("!").Id("changed"),
+			).Line()
+		}
+		// keys of all visible items (in order of storage, pending changes are the last)
+		code.Func().Params(receiver.Clone()).Id("keys" + model.Name).Params().Index().Add(keyType).BlockFunc(func(keysFunc *jen.Group) {
+			keysFunc.Var().Id("keys").Index().Add(keyType)
+			if proj.Transactional {
+				keysFunc.Add(committedStorage(model)).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.If(jen.Id("project").Dot("committed"+model.Name).Call(jen.Id(keyName))).Block(
+						jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id(keyName)),
+					),
+					jen.Return().True(),
+				))
+				keysFunc.For(jen.List(jen.Id(keyName), jen.Id("entity")).Op(":=").Range().Id("project").Dot("_pending" + model.Name)).Block(
+					jen.If(jen.Id("entity").Dot("Action").Op("!=").Id(proj.Name + "ActionDelete")).Block(
+						jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id(keyName)),
+					),
+				)
+			} else {
+				keysFunc.Add(committedStorage(model)).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id(keyName)),
+					jen.Return().True(),
+				))
+			}
+			keysFunc.Return().Id("keys")
+		}).Line()
+	}
+	return code
+}
+
+// generateOrderedWalks defines ascending and descending walks over visible items from the key (from the first or the
+// last item if key is nil). In transactional mode pending changes are sorted and merged with committed items.
+func generateOrderedWalks(code *jen.Statement, model *memdata.Model) {
+	proj := model.Project
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	keyType := jen.Id(model.FieldType(model.Indexed))
+	for _, walk := range []string{"Ascend", "Descend"} {
+		// comparison of the next key with the current one, keys after the start and the edge of walk without key
+		next, bound, edge := "<", ">=", "First"
+		if walk == "Descend" {
+			next, bound, edge = ">", "<=", "Last"
+		}
+		iterator := jen.Id("iterator").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool()
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl"+proj.Name)).Id(memdata.ToLowerCamel(walk)+model.Name).Params(jen.Id("from").Op("*").Add(keyType), iterator).BlockFunc(func(walkFunc *jen.Group) {
+			walkFunc.List(jen.Id("ordered"), jen.Id("ok")).Op(":=").Add(committedStorage(model)).Op(".").Parens(jen.Id(model.Name + "OrderedStorage"))
+			walkFunc.If(jen.Op("!").Id("ok")).BlockFunc(func(scan *jen.Group) {
+				scan.Id("keys").Op(":=").Id("project").Dot("sorted" + model.Name + "Keys").Call()
+				if walk == "Ascend" {
+					scan.Id("start").Op(":=").Lit(0)
+					scan.If(jen.Id("from").Op("!=").Nil()).Block(
+						jen.Id("start").Op("=").Qual("sort", "Search").Call(jen.Len(jen.Id("keys")), jen.Func().Params(jen.Id("i").Int()).Bool().Block(
+							jen.Return().Id("keys").Index(jen.Id("i")).Op(">=").Op("*").Id("from"),
+						)),
+					)
+					scan.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys").Index(jen.Id("start"), jen.Empty())).Block(
+						jen.If(jen.Op("!").Id("iterator").Call(visibleItem(model, jen.Id("key")))).Block(jen.Return()),
+					)
+				} else {
+					scan.Id("end").Op(":=").Len(jen.Id("keys"))
+					scan.If(jen.Id("from").Op("!=").Nil()).Block(
+						jen.Id("end").Op("=").Qual("sort", "Search").Call(jen.Len(jen.Id("keys")), jen.Func().Params(jen.Id("i").Int()).Bool().Block(
+							jen.Return().Id("keys").Index(jen.Id("i")).Op(">").Op("*").Id("from"),
+						)),
+					)
+					scan.For(jen.Id("i").Op(":=").Id("end").Op("-").Lit(1), jen.Id("i").Op(">=").Lit(0), jen.Id("i").Op("--")).Block(
+						jen.If(jen.Op("!").Id("iterator").Call(visibleItem(model, jen.Id("keys").Index(jen.Id("i"))))).Block(jen.Return()),
+					)
+				}
+				scan.Return()
+			})
+			start := func(group *jen.Group, visit jen.Code) {
+				group.If(jen.Id("from").Op("!=").Nil()).Block(
+					jen.Id("ordered").Dot(walk+model.Name).Call(jen.Op("*").Id("from"), visit),
+				).Else().If(jen.Id("item").Op(":=").Id("ordered").Dot(edge+model.Name).Call(), jen.Id("item").Op("!=").Nil()).Block(
+					jen.Id("ordered").Dot(walk+model.Name).Call(jen.Id("item").Dot(model.Indexed), visit),
+				)
+			}
+			if !proj.Transactional {
+				start(walkFunc, jen.Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Return().Id("iterator").Call(jen.Id("item")),
+				))
+				return
+			}
+			// visible pending keys in order of walk
+			walkFunc.Var().Id("pending").Index().Add(keyType)
+			walkFunc.For(jen.List(jen.Id(keyName), jen.Id("entity")).Op(":=").Range().Id("project").Dot("_pending" + model.Name)).Block(
+				jen.If(jen.Id("entity").Dot("Action").Op("!=").Id(proj.Name + "ActionDelete").Op("&&").Parens(jen.Id("from").Op("==").Nil().Op("||").Id(keyName).Op(bound).Op("*").Id("from"))).Block(
+					jen.Id("pending").Op("=").Append(jen.Id("pending"), jen.Id(keyName)),
+				),
+			)
+			walkFunc.Qual("sort", "Slice").Call(jen.Id("pending"), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+				jen.Return().Id("pending").Index(jen.Id("i")).Op(next).Id("pending").Index(jen.Id("j")),
+			))
+			// pending items before the committed one are passed first
+			walkFunc.Id("stopped").Op(":=").False()
+			start(walkFunc, jen.Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.For(jen.Len(jen.Id("pending")).Op(">").Lit(0).Op("&&").Id("pending").Index(jen.Lit(0)).Op(next).Id(keyName)).Block(
+					jen.Id("stopped").Op("=").Op("!").Id("iterator").Call(visibleItem(model, jen.Id("pending").Index(jen.Lit(0)))),
+					jen.Id("pending").Op("=").Id("pending").Index(jen.Lit(1), jen.Empty()),
+					jen.If(jen.Id("stopped")).Block(jen.Return().False()),
+				),
+				jen.If(jen.Id("project").Dot("committed"+model.Name).Call(jen.Id(keyName))).Block(
+					jen.Id("stopped").Op("=").Op("!").Id("iterator").Call(jen.Id("item")),
+				),
+				jen.Return().Op("!").Id("stopped"),
+			))
+			walkFunc.If(jen.Id("stopped")).Block(jen.Return())
+			walkFunc.For(jen.List(jen.Id("_"), jen.Id(keyName)).Op(":=").Range().Id("pending")).Block(
+				jen.If(jen.Op("!").Id("iterator").Call(visibleItem(model, jen.Id(keyName)))).Block(jen.Return()),
+			)
+		}).Line()
+	}
+}
+
+// generateOrderedIndex embeds red-black tree of indexed values and defines range queries over the index
+func generateOrderedIndex(index modelIndex) jen.Code {
+	model := index.Model
+	keyTypeName := model.FieldType(model.Indexed)
+	keyType := jen.Id(keyTypeName)
+	valueType := jen.Id(index.Type)
+	typeName := index.TypeName()
+	code := jen.Op((&tree.Tree{TypeName: index.TreeName(), KeyType: index.Type, ValueType: "map[" + keyTypeName + "]struct{}", Embedded: true}).Generate()).Line()
+	receiver := jen.Id("index").Op("*").Id(typeName)
+	// keys of set (sorted if possible)
+	code.Func().Params(receiver.Clone()).Id("setKeys").Params(jen.Id("set").Map(keyType).Struct()).Index().Add(keyType).BlockFunc(func(setFunc *jen.Group) {
+		setFunc.Id("result").Op(":=").Make(jen.Index().Add(keyType), jen.Lit(0), jen.Len(jen.Id("set")))
+		setFunc.For(jen.Id("key").Op(":=").Range().Id("set")).Block(
+			jen.Id("result").Op("=").Append(jen.Id("result"), jen.Id("key")),
+		)
+		generateSortKeys(setFunc, keyTypeName, "result")
+		setFunc.Return().Id("result")
+	}).Line()
+	// rangeKeys [from, to) -> ids
+	code.Func().Params(receiver.Clone()).Id("rangeKeys").Params(jen.List(jen.Id("from"), jen.Id("to")).Add(valueType)).Index().Add(keyType).BlockFunc(func(rangeFunc *jen.Group) {
+		rangeFunc.Var().Id("result").Index().Add(keyType)
+		rangeFunc.List(jen.Id("node"), jen.Id("found")).Op(":=").Id("index").Dot("order").Dot("Ceiling").Call(jen.Id("from"))
+		rangeFunc.If(jen.Op("!").Id("found")).Block(jen.Return().Id("result"))
+		rangeFunc.For(jen.Id("it").Op(":=").Id("index").Dot("order").Dot("IteratorAt").Call(jen.Id("node")), jen.Id("it").Dot("Key").Call().Op("<").Id("to"), jen.Empty()).Block(
+			jen.Id("result").Op("=").Append(jen.Id("result"), jen.Id("index").Dot("setKeys").Call(jen.Id("it").Dot("Value").Call()).Op("...")),
+			jen.If(jen.Op("!").Id("it").Dot("Next").Call()).Block(jen.Break()),
+		)
+		rangeFunc.Return().Id("result")
+	}).Line()
+	// edge walks from the node (forward or backward) to the nearest value with visible keys
+	nodeType := jen.Op("*").Id(index.TreeName() + "Node")
+	visibleType := jen.Func().Params(keyType.Clone()).Bool()
+	code.Func().Params(receiver.Clone()).Id("edge").Params(jen.Id("node").Add(nodeType), jen.Id("forward").Bool(), jen.Id("visible").Add(visibleType)).Params(jen.Id("value").Add(valueType), jen.Id("keys").Index().Add(keyType), jen.Id("found").Bool()).BlockFunc(func(edgeFunc *jen.Group) {
+		edgeFunc.If(jen.Id("node").Op("==").Nil()).Block(jen.Return())
+		edgeFunc.For(jen.Id("it").Op(":=").Id("index").Dot("order").Dot("IteratorAt").Call(jen.Id("node")), jen.Empty(), jen.Empty()).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("index").Dot("setKeys").Call(jen.Id("it").Dot("Value").Call())).Block(
+				jen.If(jen.Id("visible").Op("==").Nil().Op("||").Id("visible").Call(jen.Id("key"))).Block(
+					jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("key")),
+				),
+			),
+			jen.If(jen.Len(jen.Id("keys")).Op(">").Lit(0)).Block(jen.Return(jen.Id("it").Dot("Key").Call(), jen.Id("keys"), jen.True())),
+			jen.If(jen.Id("forward").Op("&&").Op("!").Id("it").Dot("Next").Call().Op("||").Op("!").Id("forward").Op("&&").Op("!").Id("it").Dot("Prev").Call()).Block(jen.Return()),
+		)
+	}).Line()
+	// first and last (min and max value) -> ids
+	for _, edge := range []string{"first", "last"} {
+		method, forward := "Left", true
+		if edge == "last" {
+			method, forward = "Right", false
+		}
+		code.Func().Params(receiver.Clone()).Id(edge).Params(jen.Id("visible").Add(visibleType)).Params(valueType.Clone(), jen.Index().Add(keyType), jen.Bool()).Block(
+			jen.Return().Id("index").Dot("edge").Call(jen.Id("index").Dot("order").Dot(method).Call(), jen.Lit(forward), jen.Id("visible")),
+		).Line()
+	}
+	// floor and ceiling (nearest value) -> ids
+	for _, edge := range []string{"Floor", "Ceiling"} {
+		code.Func().Params(receiver.Clone()).Id(memdata.ToLowerCamel(edge)).Params(jen.Id("value").Add(valueType), jen.Id("visible").Add(visibleType)).Params(valueType.Clone(), jen.Index().Add(keyType), jen.Bool()).Block(
+			jen.List(jen.Id("node"), jen.Id("_")).Op(":=").Id("index").Dot("order").Dot(edge).Call(jen.Id("value")),
+			jen.Return().Id("index").Dot("edge").Call(jen.Id("node"), jen.Lit(edge == "Ceiling"), jen.Id("visible")),
+		).Line()
+	}
+	return code
+}
+
+// generateTreeOrderedMethods implements ordered storage interface of the model by red-black tree or B-tree
+func generateTreeOrderedMethods(code *jen.Statement, objName string, model *memdata.Model, data func() jen.Code, btree bool) {
+	if !isOrderedType(model.FieldType(model.Indexed)) {
+		return
+	}
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	keyType := jen.Id(model.FieldType(model.Indexed))
+	receiver := jen.Id("storage").Op("*").Id(objName)
+	// AscendModel and DescendModel walk from ceiling or floor of the key
+	for _, walk := range []string{"Ascend", "Descend"} {
+		edge, step := "Ceiling", "Next"
+		if walk == "Descend" {
+			edge, step = "Floor", "Prev"
+		}
+		code.Func().Params(receiver.Clone()).Id(walk+model.Name).Params(jen.Id("from").Add(keyType), jen.Id("iterator").Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool()).BlockFunc(func(walkFunc *jen.Group) {
+			if btree {
+				walkFunc.List(jen.Id("it"), jen.Id("found")).Op(":=").Add(data()).Dot(edge).Call(jen.Id("from"))
+				walkFunc.If(jen.Op("!").Id("found")).Block(jen.Return())
+			} else {
+				walkFunc.List(jen.Id("node"), jen.Id("found")).Op(":=").Add(data()).Dot(edge).Call(jen.Id("from"))
+				walkFunc.If(jen.Op("!").Id("found")).Block(jen.Return())
+				walkFunc.Id("it").Op(":=").Add(data()).Dot("IteratorAt").Call(jen.Id("node"))
+			}
+			walkFunc.For(jen.Id("iterator").Call(jen.Id("it").Dot("Key").Call(), jen.Id("it").Dot("Value").Call()).Op("&&").Id("it").Dot(step).Call()).Block()
+		}).Line()
+	}
+	for _, edge := range []string{"First", "Last"} {
+		method := "Left"
+		if edge == "Last" {
+			method = "Right"
+		}
+		code.Func().Params(receiver.Clone()).Id(edge + model.Name).Params().Op("*").Id(model.Name).BlockFunc(func(edgeFunc *jen.Group) {
+			if btree {
+				edgeFunc.Return().Add(data()).Dot(method + "Value").Call()
+				return
+			}
+			edgeFunc.Id("node").Op(":=").Add(data()).Dot(method).Call()
+			edgeFunc.If(jen.Id("node").Op("==").Nil()).Block(jen.Return().Nil())
+			edgeFunc.Return().Id("node").Dot("Value")
+		}).Line()
+	}
+}
+
+// generateUnlockedIteration collects items under the read lock and passes them to the iterator after unlock, so the
+// iterator could use the project (including writes)
+func generateUnlockedIteration(group *jen.Group, model *memdata.Model, collect func(collect *jen.Group)) {
+	group.Id("items").Op(":=").Func().Params().Index().Op("*").Id(model.Name).BlockFunc(func(collectFunc *jen.Group) {
+		generateReadLock(collectFunc, model.Project)
+		collectFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+		collect(collectFunc)
+		collectFunc.Return().Id("items")
+	}).Call()
+	group.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+		jen.If(jen.Op("!").Id("iterator").Call(jen.Id("item"))).Block(jen.Return()),
+	)
+}
@@ -3,10 +3,12 @@ package model
 import (
 	"github.com/dave/jennifer/jen"
 	"github.com/reddec/memdata"
+	"sort"
 	"strings"
 )
 
-func GenerateProject(proj *memdata.Project) *jen.Statement {
+// prepareProject resolves keys and links of models and checks definitions. Prepared project could be prepared again.
+func prepareProject(proj *memdata.Project) {
 	// prepare project
 	// replace key to sequence (if it's a number) and indexed
 	for _, model := range proj.Models {
@@ -48,17 +50,151 @@ func GenerateProject(proj *memdata.Project) *jen.Statement {
 		}
 		model.Fields = cp
 	}
+	// drop duplicated and primary secondary indexes, unique fields are indexed too
+	for _, model := range proj.Models {
+		for _, field := range model.Unique {
+			model.FieldType(field)
+		}
+		for _, field := range model.Ordered {
+			if !isOrderedType(model.FieldType(field)) {
+				panic("ordered index by " + model.Name + "." + field + " requires number or string field")
+			}
+			if strings.Contains(model.FieldType(model.Indexed), ".") {
+				panic("ordered index by " + model.Name + "." + field + " requires key of builtin type")
+			}
+		}
+		model.Indexes = append(model.Indexes, model.Unique...)
+		model.Indexes = append(model.Indexes, model.Ordered...)
+		var indexes []string
+		seen := map[string]bool{model.Indexed: true}
+		for _, field := range model.Indexes {
+			model.FieldType(field)
+			if seen[field] {
+				continue
+			}
+			seen[field] = true
+			indexes = append(indexes, field)
+		}
+		model.Indexes = indexes
+	}
+	// check on-delete rules of links
+	for _, model := range proj.Models {
+		for field, rule := range model.OnDelete {
+			_, isRef := model.Ref[field]
+			_, isMany := model.HasMany[field]
+			if !isRef && !isMany {
+				panic("on_delete rule for unknown link " + model.Name + "." + field)
+			}
+			switch rule {
+			case ruleRestrict, ruleCascade, ruleSetNull, ruleIgnore:
+			default:
+				panic("unknown on_delete rule " + rule + " for " + model.Name + "." + field)
+			}
+		}
+	}
+	// check version fields
+	for _, model := range proj.Models {
+		if model.Version == "" {
+			continue
+		}
+		if !isIntegerType(model.FieldType(model.Version)) {
+			panic("version " + model.Name + "." + model.Version + " requires integer field")
+		}
+		if model.Version == model.Indexed {
+			panic("version " + model.Name + "." + model.Version + " could not be key")
+		}
+		if contains(model.AutoSequence, model.Version) {
+			panic("version " + model.Name + "." + model.Version + " could not be sequence")
+		}
+	}
+	// check validation rules, default values and timestamps
+	for _, model := range proj.Models {
+		checkValidation(model)
+		checkDefaults(model)
+	}
+	if proj.HTTP {
+		checkHTTP(proj)
+	}
+	if proj.Proto != nil {
+		checkProto(proj)
+	}
+	if proj.GraphQL {
+		checkGraphQL(proj)
+	}
+	if proj.SQL != nil {
+		checkSQL(proj)
+	}
+	if proj.KV {
+		checkKV(proj)
+	}
+	if proj.Query {
+		checkQuery(proj)
+	}
 	// prepare for transactional
 	if proj.Transactional {
 		proj.Synchronized = false
 		proj.StorageRef = false
+	} else if proj.WAL {
+		panic("write-ahead log requires transactional project")
+	} else if proj.MVCC {
+		panic("multi-version reads require transactional project")
+	}
+}
+
+func GenerateProject(proj *memdata.Project) *jen.Statement {
+	prepareProject(proj)
+	code := generateProjectInterfaces(proj).Line().Add(generateErrors(proj)).Line().Add(generateProjectStruct(proj)).Line().Add(generateProjectFuncs(proj))
+	code.Line().Add(generateSnapshot(proj))
+	code.Line().Add(generateBinaryHelpers(proj))
+	if hasTimestamps(proj) {
+		code.Line().Add(generateClock(proj))
+	}
+	if proj.WAL {
+		code.Line().Add(generateWAL(proj))
+	}
+	if proj.MVCC {
+		code.Line().Add(generateVersions(proj))
+	}
+	if proj.Transactional {
+		code.Line().Add(generateSavepoints(proj))
+	}
+	if proj.Events {
+		code.Line().Add(generateEvents(proj))
+	}
+	if proj.HTTP {
+		code.Line().Add(generateHTTPHandler(proj))
+	}
+	if proj.Proto != nil && proj.Proto.GoPackage != "" {
+		code.Line().Add(generateProtoConversions(proj))
+	}
+	if proj.GraphQL {
+		code.Line().Add(generateGraphQLResolvers(proj))
+	}
+	if proj.SQL != nil {
+		code.Line().Add(generateSQL(proj))
+	}
+	if proj.KV {
+		code.Line().Add(generateKV(proj))
+	}
+	if proj.Query {
+		code.Line().Add(generateQuery(proj))
+	}
+	if hasIndexes(proj) {
+		code.Line().Add(generateIndexTypes(proj))
 	}
-	code := generateProjectInterfaces(proj).Line().Add(generateProjectStruct(proj)).Line().Add(generateProjectFuncs(proj))
 	if proj.Transactional {
 		code.Line().Add(generateDefaultTransactionalStorage(proj))
 	} else {
 		code.Line().Add(generateDefaultStorages(proj))
 	}
+	if backend := projectBackend(proj); backend != nil {
+		code.Line().Add(backend.generateTrees(proj))
+		if proj.Transactional {
+			code.Line().Add(backend.generateTransactionalStorage(proj))
+		} else {
+			code.Line().Add(backend.generateStorages(proj))
+		}
+	}
 	return code
 }
 
@@ -77,17 +213,30 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 			indexed[indexName] = true
 			iface.Id(fnName).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name)
 		}
+		// secondary indexes search (by fields and by references)
+		for _, model := range proj.Models {
+			for _, index := range modelIndexes(model) {
+				iface.Id(model.Name + "By" + index.Name).Params(jen.Id(memdata.ToLowerCamel(index.Field)).Add(proj.Qual(index.Type))).Index().Op("*").Id(model.Name)
+			}
+		}
+		// listing, counting and existence of items
+		generateListingInterface(iface, proj)
+		// range queries by ordered keys and ordered secondary indexes
+		generateOrderedReaderInterface(iface, proj)
+		if proj.Query {
+			generateQueryInterface(iface, proj)
+		}
 	}).Line().Line()
 	// project main interface - writer
 	code.Type().Id(proj.Name + "Writer").InterfaceFunc(func(iface *jen.Group) {
 		for _, model := range proj.Models {
 			// insert models (and assign sequences)
-			iface.Id("Insert" + model.Name).Params(jen.Id("item").Op("*").Id(model.Name)).Op("*").Id(model.Name)
-			// remove models (without following links)
+			iface.Id("Insert"+model.Name).Params(withContext(proj, jen.Id("item").Op("*").Id(model.Name))...).Params(jen.Op("*").Id(model.Name), jen.Error())
+			// remove models (following on-delete rules of links)
 			keyName := memdata.ToLowerCamel(model.Indexed)
-			iface.Id("Remove" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)))
+			iface.Id("Remove" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)))...).Error()
 			// update model
-			iface.Id("Update" + model.Name).Params(jen.Id("item").Op("*").Id(model.Name)).Op("*").Id(model.Name)
+			iface.Id("Update"+model.Name).Params(withContext(proj, jen.Id("item").Op("*").Id(model.Name))...).Params(jen.Op("*").Id(model.Name), jen.Error())
 		}
 	}).Line().Line()
 	// read-writer
@@ -101,8 +250,13 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 		code.Type().Id(proj.Name + "ReadWriterTx").InterfaceFunc(func(iface *jen.Group) {
 			iface.Id(proj.Name + "Reader")
 			iface.Id(proj.Name + "Writer")
-			iface.Id("Commit").Params()
+			if commitError(proj) {
+				iface.Id("Commit").Params(withContext(proj)...).Error()
+			} else {
+				iface.Id("Commit").Params()
+			}
 			iface.Id("Discard").Params()
+			generateSavepointInterface(iface, proj)
 		}).Line().Line()
 		code.Type().Id(proj.Name + "ReaderTx").InterfaceFunc(func(iface *jen.Group) {
 			iface.Id(proj.Name + "Reader")
@@ -110,8 +264,10 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 		}).Line().Line()
 		// add action enum definitions
 		code.Add(generateTransactionalDefines(proj))
+	} else if proj.Events {
+		// changes are published as log entities
+		code.Add(generateTransactionalDefines(proj))
 	}
-
 	// project main interface
 	code.Type().Id(proj.Name).InterfaceFunc(func(iface *jen.Group) {
 		if proj.Transactional {
@@ -122,6 +278,17 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 			// plain read-write - alias to ReadWriter
 			iface.Id(proj.Name + "ReadWriter")
 		}
+		// persistence
+		generateSnapshotInterface(iface)
+		if hasTimestamps(proj) {
+			iface.Id("SetClock").Params(jen.Id("clock").Func().Params().Qual("time", "Time"))
+		}
+		if proj.Events {
+			generateEventsInterface(iface, proj)
+		}
+		if proj.WAL {
+			iface.Id("Compact").Params().Error()
+		}
 	}).Line().Line()
 	if proj.Transactional {
 		// transactional models storage should be only one
@@ -130,11 +297,11 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 				keyName := memdata.ToLowerCamel(model.Indexed)
 				// GetModel (id) -> value
 				iface.Id("Get" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name)
-				// IterateModel callback(id, value)
-				iface.Id("Iterate" + model.Name).Params(jen.Id("iterator").Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)))
+				// IterateModel callback(id, value) -> continue
+				iface.Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model)))
 			}
 			// transactional changes
-			iface.Id("Apply").Params(jen.Id("batch").Index().Id(proj.Name + "LogEntity"))
+			iface.Id("Apply").Params(withContext(proj, jen.Id("batch").Index().Id(proj.Name+"LogEntity"))...).Add(storageError(proj))
 		}).Line().Line()
 
 	} else {
@@ -143,18 +310,19 @@ func generateProjectInterfaces(proj *memdata.Project) *jen.Statement {
 			keyName := memdata.ToLowerCamel(model.Indexed)
 			code = code.Type().Id(model.Name + "Storage").InterfaceFunc(func(iface *jen.Group) {
 				// PutModel (id, value)
-				iface.Id("Put"+model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))
+				iface.Id("Put" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj))
 				// UpdateModel (id, newValue)
-				iface.Id("Update"+model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))
+				iface.Id("Update" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj))
 				// GetModel (id) -> value
 				iface.Id("Get" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name)
 				// DeleteModel (id)
-				iface.Id("Delete" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)))
-				// IterateModel callback(id, value)
-				iface.Id("Iterate" + model.Name).Params(jen.Id("iterator").Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)))
+				iface.Id("Delete" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)))...).Add(storageError(proj))
+				// IterateModel callback(id, value) -> continue
+				iface.Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model)))
 			}).Line().Line()
 		}
 	}
+	code.Add(generateOrderedStorageInterfaces(proj))
 	return code
 }
 
@@ -173,27 +341,31 @@ func generateDefaultStorages(proj *memdata.Project) *jen.Statement {
 		// define methods
 
 		// PutModel (id, value)
-		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Put"+model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).BlockFunc(func(putFunc *jen.Group) {
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Put" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(putFunc *jen.Group) {
 			putFunc.Id("storage").Dot("data").Index(jen.Id(keyName)).Op("=").Id("item")
+			generateStorageResult(putFunc, proj)
 		}).Line()
 		// UpdateModel (id, value)
-		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Update"+model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).BlockFunc(func(putFunc *jen.Group) {
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Update" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(putFunc *jen.Group) {
 			putFunc.Id("storage").Dot("data").Index(jen.Id(keyName)).Op("=").Id("item")
+			generateStorageResult(putFunc, proj)
 		}).Line()
 		// GetModel (id) -> value
 		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Get" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
 			getFunc.Return().Id("storage").Dot("data").Index(jen.Id(keyName))
 		}).Line()
 		// DeleteModel (id)
-		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Delete" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).BlockFunc(func(delFunc *jen.Group) {
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Delete" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)))...).Add(storageError(proj)).BlockFunc(func(delFunc *jen.Group) {
 			delFunc.Delete(jen.Id("storage").Dot("data"), jen.Id(keyName))
+			generateStorageResult(delFunc, proj)
 		}).Line()
-		// IterateModel callback(id, value)
-		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))).BlockFunc(func(iterFunc *jen.Group) {
+		// IterateModel callback(id, value) -> continue
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).BlockFunc(func(iterFunc *jen.Group) {
 			iterFunc.For(jen.List(jen.Id("key"), jen.Id("item")).Op(":=").Range().Id("storage").Dot("data")).BlockFunc(func(rangeBlock *jen.Group) {
-				rangeBlock.Id("iterator").Call(jen.Id("key"), jen.Id("item"))
+				rangeBlock.If(jen.Op("!").Id("iterator").Call(jen.Id("key"), jen.Id("item"))).Block(jen.Return())
 			})
 		}).Line()
+		generateStorageIter(code, objName, model)
 	}
 	return code
 }
@@ -220,14 +392,15 @@ func generateDefaultTransactionalStorage(proj *memdata.Project) jen.Code {
 		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Get" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name).BlockFunc(func(getFunc *jen.Group) {
 			getFunc.Return().Id("storage").Dot(model.Name).Index(jen.Id(keyName))
 		}).Line()
-		// IterateModel callback(id, value)
-		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name))).BlockFunc(func(iterFunc *jen.Group) {
+		// IterateModel callback(id, value) -> continue
+		code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iterate" + model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).BlockFunc(func(iterFunc *jen.Group) {
 			iterFunc.For(jen.List(jen.Id("key"), jen.Id("item")).Op(":=").Range().Id("storage").Dot(model.Name)).BlockFunc(func(rangeBlock *jen.Group) {
-				rangeBlock.Id("iterator").Call(jen.Id("key"), jen.Id("item"))
+				rangeBlock.If(jen.Op("!").Id("iterator").Call(jen.Id("key"), jen.Id("item"))).Block(jen.Return())
 			})
 		}).Line()
+		generateStorageIter(code, objName, model)
 	}
-	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Apply").Params(jen.Id("batch").Index().Id(proj.Name + "LogEntity")).BlockFunc(func(batchFunc *jen.Group) {
+	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Apply").Params(withContext(proj, jen.Id("batch").Index().Id(proj.Name+"LogEntity"))...).Add(storageError(proj)).BlockFunc(func(batchFunc *jen.Group) {
 		batchFunc.For().List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch").BlockFunc(func(batchItem *jen.Group) {
 			for _, model := range proj.Models {
 				batchItem.If(jen.Id("tx").Dot(model.Name).Op("!=").Nil()).BlockFunc(func(modelChange *jen.Group) {
@@ -245,6 +418,7 @@ func generateDefaultTransactionalStorage(proj *memdata.Project) jen.Code {
 				})
 			}
 		})
+		generateStorageResult(batchFunc, proj)
 	}).Line()
 	return code
 }
@@ -266,16 +440,47 @@ func generateProjectStruct(proj *memdata.Project) *jen.Statement {
 				st.Id("index" + model.Name + "By" + model.Indexed).Id(model.Name + "Storage")
 			}
 		}
+		// secondary indexes
+		for _, model := range proj.Models {
+			for _, index := range modelIndexes(model) {
+				st.Id(index.FieldName()).Op("*").Id(index.TypeName())
+			}
+		}
 		// global lock if synchronized
 		if proj.Synchronized {
 			st.Id("_lock").Qual("sync", "RWMutex")
 		}
-
+		// source of automatic timestamps
+		if hasTimestamps(proj) {
+			st.Id("_clock").Func().Params().Qual("time", "Time")
+		}
+		// subscribers and recorded (not published) events
+		if proj.Events {
+			st.Id("_subscribers").Id(subscribersType(proj))
+			st.Id("_queue").Id(queueType(proj))
+			if !proj.Transactional {
+				st.Id("_events").Index().Id(eventType(proj))
+			}
+		}
 		if proj.Transactional {
 			// rw-lock
 			st.Id("_tx").Qual("sync", "RWMutex")
 			// changes
 			st.Id("_log").Index().Id(proj.Name + "LogEntity")
+			st.Id("_savepoints").Index().Id(memdata.ToLowerCamel(proj.Name) + "Savepoint")
+			if proj.WAL {
+				st.Id("_wal").Op("*").Id(proj.Name + "WAL")
+			}
+			if proj.MVCC {
+				generateVersionFields(st, proj)
+			}
+			// pending (not committed) state of changed items and their secondary indexes
+			for _, model := range proj.Models {
+				st.Id("_pending" + model.Name).Map(jen.Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name + "LogEntity")
+				for _, index := range modelIndexes(model) {
+					st.Id(index.PendingName()).Op("*").Id(index.TypeName())
+				}
+			}
 		}
 	})
 }
@@ -287,15 +492,28 @@ func generateProjectFuncs(proj *memdata.Project) jen.Code {
 		if proj.Transactional {
 			// single transactional storage
 			paramsBlock.Id("storage").Id(proj.Name + "TxStorage")
+			if proj.WAL {
+				paramsBlock.Id("wal").Op("*").Id(proj.Name + "WAL")
+			}
 		} else {
 			// regular single storage for each model
 			for _, model := range proj.Models {
 				paramsBlock.Id("storage" + model.Name + "By" + model.Indexed).Id(model.Name + "Storage")
 			}
 		}
-	}).Id(proj.Name).BlockFunc(func(initFunc *jen.Group) {
+	}).ParamsFunc(func(results *jen.Group) {
+		results.Id(proj.Name)
+		if proj.WAL {
+			results.Error()
+		}
+	}).BlockFunc(func(initFunc *jen.Group) {
+		// replay committed changes
+		if proj.WAL {
+			initFunc.If(jen.Id("wal").Op("!=").Nil()).Block(
+				jen.If(jen.Err().Op(":=").Id("wal").Dot("replay").Call(jen.Id("storage")), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+			)
+		}
 		// restore sequences if needed
-
 		for _, model := range proj.Models {
 			keyName := memdata.ToLowerCamel(model.Indexed)
 			storName := "storage" + model.Name + "By" + model.Indexed
@@ -307,67 +525,185 @@ func generateProjectFuncs(proj *memdata.Project) jen.Code {
 				// iterate over all storage to get maximum of stored value
 				varName := "max" + field + "Of" + model.Name
 				initFunc.Var().Id(varName).Int64()
-				initFunc.Id(storName).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).BlockFunc(func(iterFunc *jen.Group) {
-					iterFunc.If(jen.Id(keyName).Op(">").Id(varName)).Block(jen.Id(varName).Op("=").Id(keyName))
+				initFunc.Id(storName).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool().BlockFunc(func(iterFunc *jen.Group) {
+					iterFunc.If(jen.Id("item").Dot(field).Op(">").Id(varName)).Block(jen.Id(varName).Op("=").Id("item").Dot(field))
+					iterFunc.Return().True()
 				}))
 			}
 		}
 		// setup fields
-		initFunc.ReturnFunc(func(rt *jen.Group) {
-			rt.Op("&").Id("impl" + proj.Name).ValuesFunc(func(fv *jen.Group) {
-				if proj.Transactional {
-					fv.Id("storage").Op(":").Id("storage")
-				} else {
-					for _, model := range proj.Models {
-						item := "index" + model.Name + "By" + model.Indexed
-						fv.Id(item).Op(":").Id("storage" + model.Name + "By" + model.Indexed)
-					}
+		initFunc.Id("project").Op(":=").Op("&").Id("impl" + proj.Name).ValuesFunc(func(fv *jen.Group) {
+			if proj.Transactional {
+				fv.Id("storage").Op(":").Id("storage")
+				if proj.WAL {
+					fv.Id("_wal").Op(":").Id("wal")
+				}
+				if proj.MVCC {
+					generateVersionValues(fv, proj)
 				}
-				// setup sequences from restored values
+			} else {
 				for _, model := range proj.Models {
-					for _, field := range model.AutoSequence {
-						fv.Id("sequence" + model.Name + field).Op(":").Id("max" + field + "Of" + model.Name)
-					}
+					item := "index" + model.Name + "By" + model.Indexed
+					fv.Id(item).Op(":").Id("storage" + model.Name + "By" + model.Indexed)
 				}
-			})
+			}
+			// setup sequences from restored values
+			for _, model := range proj.Models {
+				for _, field := range model.AutoSequence {
+					fv.Id("sequence" + model.Name + field).Op(":").Id("max" + field + "Of" + model.Name)
+				}
+			}
+			// empty secondary indexes
+			for _, model := range proj.Models {
+				for _, index := range modelIndexes(model) {
+					fv.Id(index.FieldName()).Op(":").Id(index.Constructor()).Call()
+				}
+			}
+			if hasTimestamps(proj) {
+				fv.Id("_clock").Op(":").Qual("time", "Now")
+			}
 		})
+		// restore secondary indexes
+		for _, model := range proj.Models {
+			if len(modelIndexes(model)) == 0 {
+				continue
+			}
+			keyName := memdata.ToLowerCamel(model.Indexed)
+			storName := "storage" + model.Name + "By" + model.Indexed
+			if proj.Transactional {
+				storName = "storage"
+			}
+			initFunc.Id(storName).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool().BlockFunc(func(iterFunc *jen.Group) {
+				generateIndexUpdate(iterFunc, model, jen.Id(keyName), jen.Id("item"))
+				iterFunc.Return().True()
+			}))
+		}
+		if proj.Transactional {
+			initFunc.Id("project").Dot("resetPending").Call()
+		}
+		if proj.SQL != nil || proj.KV {
+			// items decoded by SQL or KV storages refer to the project
+			if proj.Transactional {
+				generateStorageLink(initFunc, proj, "storage")
+			} else {
+				for _, model := range proj.Models {
+					generateStorageLink(initFunc, proj, "storage"+model.Name+"By"+model.Indexed)
+				}
+			}
+		}
+		if proj.WAL {
+			initFunc.Return(jen.Id("project"), jen.Nil())
+		} else {
+			initFunc.Return().Id("project")
+		}
 	}).Line()
-	// default constructor (based on map)
+	// default constructor (based on map or tree storages)
+	backend := projectBackend(proj)
 	fs = fs.Func().Id("Default" + proj.Name).Params().Id(proj.Name).BlockFunc(func(initFunc *jen.Group) {
-		initFunc.Return().Id("New" + proj.Name).CallFunc(func(callParams *jen.Group) {
-			if proj.Transactional {
+		constructor := jen.Id("New" + proj.Name).CallFunc(func(callParams *jen.Group) {
+			if proj.Transactional && backend != nil {
+				callParams.Id("New" + backend.Prefix + proj.Name + "Storage").Call(backend.Args()...)
+			} else if proj.Transactional {
 				callParams.Id("NewMap" + proj.Name + "Storage").Call()
 			} else {
 				for _, model := range proj.Models {
+					if backend != nil {
+						callParams.Id("New" + backend.Prefix + model.Name + "Storage").Call(backend.Args()...)
+						continue
+					}
 					callParams.Id("NewMap" + model.Name + "Storage").Call()
 				}
 			}
+			if proj.WAL {
+				callParams.Nil()
+			}
 		})
+		if proj.WAL {
+			// without write-ahead log constructor never fails
+			initFunc.List(jen.Id("project"), jen.Id("_")).Op(":=").Add(constructor)
+			initFunc.Return().Id("project")
+		} else {
+			initFunc.Return().Add(constructor)
+		}
 	}).Line()
 	if proj.Transactional {
-		// generate access to read-view and write-lock transactions
-		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("ReadLock").Params().Id(proj.Name + "ReaderTx").BlockFunc(func(txFunc *jen.Group) {
-			txFunc.Id("project").Dot("_tx").Dot("RLock").Call()
-			txFunc.Return(jen.Id("project"))
-		}).Line()
+		// generate access to read-view (views of versions in multi-version mode) and write-lock transactions
+		if !proj.MVCC {
+			fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("ReadLock").Params().Id(proj.Name + "ReaderTx").BlockFunc(func(txFunc *jen.Group) {
+				txFunc.Id("project").Dot("_tx").Dot("RLock").Call()
+				txFunc.Return(jen.Id("project"))
+			}).Line()
+		}
 		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("ReadWriteLock").Params().Id(proj.Name + "ReadWriterTx").BlockFunc(func(txFunc *jen.Group) {
 			txFunc.Id("project").Dot("_tx").Dot("Lock").Call()
 			txFunc.Return(jen.Id("project"))
 		}).Line()
 		// unlocks
-		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("ReadUnlock").Params().BlockFunc(func(txFunc *jen.Group) {
-			txFunc.Id("project").Dot("_tx").Dot("RUnlock").Call()
-		}).Line()
-		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Commit").Params().BlockFunc(func(txFunc *jen.Group) {
-			txFunc.Id("project").Dot("storage").Dot("Apply").Call(jen.Id("project").Dot("_log"))
+		if !proj.MVCC {
+			fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("ReadUnlock").Params().BlockFunc(func(txFunc *jen.Group) {
+				txFunc.Id("project").Dot("_tx").Dot("RUnlock").Call()
+			}).Line()
+		}
+		// in errors mode failed commit keeps the transaction: it could be committed again or discarded
+		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Commit").Params(withContext(proj)...).ParamsFunc(func(results *jen.Group) {
+			if commitError(proj) {
+				results.Error()
+			}
+		}).BlockFunc(func(txFunc *jen.Group) {
+			logged := func() *jen.Statement {
+				return jen.Id("project").Dot("_wal").Op("!=").Nil().Op("&&").Len(jen.Id("project").Dot("_log")).Op(">").Lit(0)
+			}
+			var cutLog []jen.Code
+			if proj.WAL && proj.Errors {
+				// batch which is not applied to storage is cut off from the log
+				txFunc.Var().Id("walSize").Int64()
+				cutLog = append(cutLog, jen.If(logged()).Block(
+					jen.If(jen.Id("walErr").Op(":=").Id("project").Dot("_wal").Dot("truncate").Call(jen.Id("walSize")), jen.Id("walErr").Op("!=").Nil()).Block(
+						jen.Return().Qual("errors", "Join").Call(jen.Err(), jen.Id("walErr")),
+					),
+				))
+			}
+			if proj.WAL {
+				// changes are applied only after they are durable
+				txFunc.If(logged()).BlockFunc(func(walFunc *jen.Group) {
+					if proj.Errors {
+						walFunc.Id("walSize").Op("=").Id("project").Dot("_wal").Dot("size")
+					}
+					walFunc.If(jen.Err().Op(":=").Id("project").Dot("_wal").Dot("append").Call(jen.Id("project").Dot("_log")), jen.Err().Op("!=").Nil()).BlockFunc(func(failFunc *jen.Group) {
+						if !proj.Errors {
+							failFunc.Id("project").Dot("Discard").Call()
+						}
+						failFunc.Return().Err()
+					})
+				})
+			}
+			if proj.Events {
+				txFunc.Id("events").Op(":=").Id("project").Dot("collectEvents").Call(jen.Id("project").Dot("_log"))
+			}
+			generateApplyBatch(txFunc, proj, jen.Id("project").Dot("_log"), cutLog...)
+			if proj.Events {
+				// events are queued in order of commits and handlers are called after unlock
+				txFunc.Id("project").Dot("enqueue").Call(jen.Id("events"))
+			}
 			txFunc.Id("project").Dot("Discard").Call()
+			if proj.Events {
+				txFunc.Id("project").Dot("drain").Call()
+			}
+			if commitError(proj) {
+				txFunc.Return().Nil()
+			}
 		}).Line()
 		fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Discard").Params().BlockFunc(func(txFunc *jen.Group) {
-			txFunc.If(jen.Id("project").Dot("_log").Op("!=").Nil()).BlockFunc(func(ifLogExists *jen.Group) {
+			txFunc.If(jen.Len(jen.Id("project").Dot("_log")).Op(">").Lit(0)).BlockFunc(func(ifLogExists *jen.Group) {
 				ifLogExists.Id("project").Dot("_log").Op("=").Id("project").Dot("_log").Index(jen.Empty(), jen.Lit(0))
+				ifLogExists.Id("project").Dot("resetPending").Call()
 			})
+			txFunc.Id("project").Dot("_savepoints").Op("=").Id("project").Dot("_savepoints").Index(jen.Empty(), jen.Lit(0))
 			txFunc.Id("project").Dot("_tx").Dot("Unlock").Call()
 		}).Line()
+		if hasIndexes(proj) {
+			fs.Add(generateIndexApply(proj))
+		}
 	}
 	indexed := make(map[string]bool)
 	// index search and access
@@ -381,7 +717,9 @@ func generateProjectFuncs(proj *memdata.Project) jen.Code {
 		indexed[indexName] = true
 		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id(fnName).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name).BlockFunc(func(indexFunc *jen.Group) {
 			if proj.Transactional {
-				indexFunc.Return().Id("project").Dot("storage").Dot("Get" + model.Name).Call(jen.Id(keyName))
+				// committed state overlapped by pending changes
+				generateReadLock(indexFunc, proj)
+				indexFunc.Return().Id("project").Dot("get" + model.Name).Call(jen.Id(keyName))
 			} else if proj.Synchronized {
 				indexFunc.Id("project").Dot("_lock").Dot("RLock").Call()
 				indexFunc.Defer().Id("project").Dot("_lock").Dot("RUnlock").Call()
@@ -392,13 +730,23 @@ func generateProjectFuncs(proj *memdata.Project) jen.Code {
 
 		}).Line()
 	}
+	// secondary index search (by fields and by references)
+	for _, model := range proj.Models {
+		for _, index := range modelIndexes(model) {
+			valueName := memdata.ToLowerCamel(index.Field)
+			fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id(model.Name + "By" + index.Name).Params(jen.Id(valueName).Add(proj.Qual(index.Type))).Index().Op("*").Id(model.Name).BlockFunc(func(indexFunc *jen.Group) {
+				generateReadLock(indexFunc, proj)
+				generateVisibleItems(indexFunc, model, jen.Id("project").Dot("find"+model.Name+"By"+index.Name).Call(jen.Id(valueName)))
+			}).Line()
+		}
+	}
 	// sequence access methods
 	for _, model := range proj.Models {
 		for _, field := range model.AutoSequence {
 			fName := "Next" + model.Name + field
 			fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id(fName).Params().Int64().BlockFunc(func(indexFunc *jen.Group) {
 				if proj.Synchronized {
-					indexFunc.Return().Qual("atomic", "AddInt64").Call(jen.Id("project").Dot("sequence"+model.Name+field), jen.Lit(1))
+					indexFunc.Return().Qual("sync/atomic", "AddInt64").Call(jen.Op("&").Id("project").Dot("sequence"+model.Name+field), jen.Lit(1))
 				} else {
 					indexFunc.Id("project").Dot("sequence" + model.Name + field).Op("++")
 					indexFunc.Return().Id("project").Dot("sequence" + model.Name + field)
@@ -408,77 +756,196 @@ func generateProjectFuncs(proj *memdata.Project) jen.Code {
 	}
 	// insert models (and assign sequences)
 	for _, model := range proj.Models {
-		indexName := model.Name + "By" + model.Indexed
-		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Insert" + model.Name).Params(jen.Id("item").Op("*").Id(model.Name)).Op("*").Id(model.Name).BlockFunc(func(indexFunc *jen.Group) {
-
+		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl"+proj.Name)).Id("Insert"+model.Name).Params(withContext(proj, jen.Id("item").Op("*").Id(model.Name))...).Params(jen.Op("*").Id(model.Name), jen.Error()).BlockFunc(func(indexFunc *jen.Group) {
+			// checks of candidate values: rejected item is not changed and doesn't consume sequences
+			indexFunc.Id("candidate").Op(":=").New(jen.Id(model.Name))
+			indexFunc.Op("*").Id("candidate").Op("=").Op("*").Id("item")
 			for _, auto := range model.AutoSequence {
-				indexFunc.Id("item").Dot(auto).Op("=").Id("project").Dot("Next" + model.Name + auto).Call()
+				// not assigned yet: the candidate doesn't conflict with the stored item of the same key
+				indexFunc.Id("candidate").Dot(auto).Op("=").Lit(0)
 			}
-			indexFunc.Id("item").Dot("_project").Op("=").Id("project")
-			if proj.Transactional {
-				indexFunc.Id("project").Dot("_log").Op("=").Append(jen.Id("project").Dot("_log"), jen.Id(proj.Name+"LogEntity").ValuesFunc(func(logFn *jen.Group) {
-					logFn.Id(model.Name).Op(":").Op("&").Id(model.Name + "LogEntity").ValuesFunc(func(modelLog *jen.Group) {
-						modelLog.Id(model.Indexed).Op(":").Id("item").Dot(model.Indexed)
-						modelLog.Id("Item").Op(":").Op("*").Id("item")
-						modelLog.Id("Action").Op(":").Id(proj.Name + "ActionInsert")
-					})
-				}))
-			} else if proj.Synchronized {
-				indexFunc.Id("project").Dot("_lock").Dot("Lock").Call()
-				indexFunc.Id("project").Dot("index"+indexName).Dot("Put"+model.Name).Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))
-				indexFunc.Id("project").Dot("_lock").Dot("Unlock").Call()
-			} else {
-				indexFunc.Id("project").Dot("index"+indexName).Dot("Put"+model.Name).Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))
+			generateDefaults(indexFunc, model, "candidate")
+			generateValidationCheck(indexFunc, model, "candidate")
+			generateWriteLock(indexFunc, proj)
+			generateUniqueCheck(indexFunc, model, "candidate")
+			generateReferencesCheck(indexFunc, model, "candidate")
+			// failed write of storage returns sequences (if not taken by others) and doesn't change the item
+			var undo []jen.Code
+			for _, auto := range model.AutoSequence {
+				indexFunc.Id("candidate").Dot(auto).Op("=").Id("project").Dot("Next" + model.Name + auto).Call()
+				sequence := jen.Id("project").Dot("sequence" + model.Name + auto)
+				if proj.Synchronized {
+					undo = append(undo, jen.Qual("sync/atomic", "CompareAndSwapInt64").Call(jen.Op("&").Add(sequence), jen.Id("candidate").Dot(auto), jen.Id("candidate").Dot(auto).Op("-").Lit(1)))
+				} else {
+					undo = append(undo, jen.If(jen.Add(sequence).Op("==").Id("candidate").Dot(auto)).Block(jen.Add(sequence).Op("--")))
+				}
 			}
-			indexFunc.Return().Id("item")
+			indexFunc.Id("candidate").Dot("_project").Op("=").Id("project")
+			if model.Version != "" {
+				indexFunc.Id("candidate").Dot(model.Version).Op("=").Lit(1)
+			}
+			generateTimestamps(indexFunc, model, "Insert", "candidate")
+			generateAcceptCandidate(indexFunc, model, "Insert", undo, jen.Nil())
+			indexFunc.Return(jen.Id("item"), jen.Nil())
 		}).Line()
 	}
 	// update models (without assign sequences)
 	for _, model := range proj.Models {
-		indexName := model.Name + "By" + model.Indexed
-		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Update" + model.Name).Params(jen.Id("item").Op("*").Id(model.Name)).Op("*").Id(model.Name).BlockFunc(func(indexFunc *jen.Group) {
-			if proj.Transactional {
-				indexFunc.Id("project").Dot("_log").Op("=").Append(jen.Id("project").Dot("_log"), jen.Id(proj.Name+"LogEntity").ValuesFunc(func(logFn *jen.Group) {
-					logFn.Id(model.Name).Op(":").Op("&").Id(model.Name + "LogEntity").ValuesFunc(func(modelLog *jen.Group) {
-						modelLog.Id(model.Indexed).Op(":").Id("item").Dot(model.Indexed)
-						modelLog.Id("Item").Op(":").Op("*").Id("item")
-						modelLog.Id("Action").Op(":").Id(proj.Name + "ActionUpdate")
-					})
-				}))
-			} else if proj.Synchronized {
-				indexFunc.Id("project").Dot("_lock").Dot("Lock").Call()
-				indexFunc.Id("project").Dot("index"+indexName).Dot("Update"+model.Name).Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))
-				indexFunc.Id("project").Dot("_lock").Dot("Unlock").Call()
-			} else {
-				indexFunc.Id("project").Dot("index"+indexName).Dot("Update"+model.Name).Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))
+		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl"+proj.Name)).Id("Update"+model.Name).Params(withContext(proj, jen.Id("item").Op("*").Id(model.Name))...).Params(jen.Op("*").Id(model.Name), jen.Error()).BlockFunc(func(indexFunc *jen.Group) {
+			// version and timestamps are set on copy: rejected or failed item is not changed
+			indexFunc.Id("candidate").Op(":=").New(jen.Id(model.Name))
+			indexFunc.Op("*").Id("candidate").Op("=").Op("*").Id("item")
+			generateValidationCheck(indexFunc, model, "candidate")
+			generateWriteLock(indexFunc, proj)
+			generateVersionCheck(indexFunc, model)
+			generateUniqueCheck(indexFunc, model, "candidate")
+			generateReferencesCheck(indexFunc, model, "candidate")
+			if model.Version != "" {
+				indexFunc.Id("candidate").Dot(model.Version).Op("++")
 			}
-			indexFunc.Return().Id("item")
+			generateTimestamps(indexFunc, model, "Update", "candidate")
+			generateAcceptCandidate(indexFunc, model, "Update", nil, jen.Nil())
+			indexFunc.Return(jen.Id("item"), jen.Nil())
 		}).Line()
 	}
-	// remove models (without following links)
+	// remove models (following on-delete rules of links)
+	checks := removeChecks(proj)
 	for _, model := range proj.Models {
-		indexName := model.Name + "By" + model.Indexed
 		keyName := memdata.ToLowerCamel(model.Indexed)
-		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Remove" + model.Name).Params(jen.Id(keyName).Id(model.FieldType(model.Indexed))).BlockFunc(func(indexFunc *jen.Group) {
-			if proj.Transactional {
-				indexFunc.Id("project").Dot("_log").Op("=").Append(jen.Id("project").Dot("_log"), jen.Id(proj.Name+"LogEntity").ValuesFunc(func(logFn *jen.Group) {
-					logFn.Id(model.Name).Op(":").Op("&").Id(model.Name + "LogEntity").ValuesFunc(func(modelLog *jen.Group) {
-						modelLog.Id(model.Indexed).Op(":").Id(keyName)
-						modelLog.Id("Action").Op(":").Id(proj.Name + "ActionDelete")
-					})
-				}))
-			} else if proj.Synchronized {
-				indexFunc.Id("project").Dot("_lock").Dot("Lock").Call()
-				indexFunc.Id("project").Dot("index" + indexName).Dot("Delete" + model.Name).Call(jen.Id(keyName))
-				indexFunc.Id("project").Dot("_lock").Dot("Unlock").Call()
-			} else {
-				indexFunc.Id("project").Dot("index" + indexName).Dot("Delete" + model.Name).Call(jen.Id(keyName))
+		fs = fs.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Remove" + model.Name).Params(withContext(proj, jen.Id(keyName).Id(model.FieldType(model.Indexed)))...).Error().BlockFunc(func(indexFunc *jen.Group) {
+			generateWriteLock(indexFunc, proj)
+			if checks[model] {
+				indexFunc.If(jen.Err().Op(":=").Id("project").Dot("checkRemove"+model.Name).Call(jen.Id(keyName), jen.Op("&").Id(memdata.ToLowerCamel(proj.Name)+"Removal").Values()), jen.Err().Op("!=").Nil()).Block(
+					jen.Return().Err(),
+				)
 			}
+			if writeErrors(proj) {
+				indexFunc.Return().Id("project").Dot("remove"+model.Name).Call(jen.Id("ctx"), jen.Id(keyName))
+				return
+			}
+			indexFunc.Id("project").Dot("remove" + model.Name).Call(jen.Id(keyName))
+			indexFunc.Return().Nil()
 		}).Line()
 	}
+	if proj.Transactional {
+		fs.Add(generateTransactionLog(proj))
+	}
+	if hasIndexes(proj) {
+		fs.Add(generateIndexFind(proj))
+	}
+	fs.Add(generateReferences(proj))
+	fs.Add(generateOrderedReader(proj))
+	fs.Add(generateListing(proj))
+	if hasUnique(proj) {
+		fs.Add(generateUniqueChecks(proj))
+	}
 	return fs
 }
 
+// generateAcceptCandidate copies checked candidate to the item and writes the item (storage keeps pointer of caller).
+// Failed write of storage (errors mode) restores previous values of the item and executes statements of undo.
+func generateAcceptCandidate(group *jen.Group, model *memdata.Model, action string, undo []jen.Code, fail ...jen.Code) {
+	if writeErrors(model.Project) {
+		group.Id("origin").Op(":=").Op("*").Id("item")
+		undo = append([]jen.Code{jen.Op("*").Id("item").Op("=").Id("origin")}, undo...)
+	}
+	group.Op("*").Id("item").Op("=").Op("*").Id("candidate")
+	generateUndoableWrite(group, model, action, undo, fail...)
+}
+
+// generateWrite passes changes of item (or removal by key) to the storage (or transaction log) and secondary indexes.
+// Failed write of storage returns fail values and error of storage (errors mode).
+func generateWrite(group *jen.Group, model *memdata.Model, action string, fail ...jen.Code) {
+	generateUndoableWrite(group, model, action, nil, fail...)
+}
+
+// generateUndoableWrite is generateWrite with statements of undo executed on failed write of storage
+func generateUndoableWrite(group *jen.Group, model *memdata.Model, action string, undo []jen.Code, fail ...jen.Code) {
+	proj := model.Project
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	storage := jen.Id("project").Dot("index" + model.Name + "By" + model.Indexed)
+	if proj.Transactional {
+		group.Id("project").Dot("log" + model.Name).Call(jen.Op("&").Id(model.Name + "LogEntity").ValuesFunc(func(modelLog *jen.Group) {
+			if action == "Delete" {
+				modelLog.Id(model.Indexed).Op(":").Id(keyName)
+			} else {
+				modelLog.Id(model.Indexed).Op(":").Id("item").Dot(model.Indexed)
+				modelLog.Id("Item").Op(":").Op("*").Id("item")
+			}
+			modelLog.Id("Action").Op(":").Id(proj.Name + "Action" + action)
+		}))
+		return
+	}
+	if proj.Events {
+		generatePreviousItem(group, model, action)
+	}
+	switch action {
+	case "Insert":
+		generateUndoableStorageWrite(group, proj, storage.Dot("Put"+model.Name).Call(contextArg(proj, jen.Id("item").Dot(model.Indexed), jen.Id("item"))...), undo, fail...)
+		generateIndexUpdate(group, model, jen.Id("item").Dot(model.Indexed), jen.Id("item"))
+	case "Update":
+		generateUndoableStorageWrite(group, proj, storage.Dot("Update"+model.Name).Call(contextArg(proj, jen.Id("item").Dot(model.Indexed), jen.Id("item"))...), undo, fail...)
+		generateIndexUpdate(group, model, jen.Id("item").Dot(model.Indexed), jen.Id("item"))
+	case "Delete":
+		generateUndoableStorageWrite(group, proj, storage.Dot("Delete"+model.Name).Call(contextArg(proj, jen.Id(keyName))...), undo, fail...)
+		generateIndexUpdate(group, model, jen.Id(keyName), nil)
+	}
+	if proj.Events {
+		generateRecordEvent(group, model, action)
+	}
+}
+
+// generateReadLock locks state shared with writers for the rest of reader function: global lock if synchronized or
+// commit lock in multi-version mode
+func generateReadLock(group *jen.Group, proj *memdata.Project) {
+	if proj.Synchronized {
+		group.Id("project").Dot("_lock").Dot("RLock").Call()
+		group.Defer().Id("project").Dot("_lock").Dot("RUnlock").Call()
+	}
+	if proj.MVCC {
+		group.Id("project").Dot("_commit").Dot("RLock").Call()
+		group.Defer().Id("project").Dot("_commit").Dot("RUnlock").Call()
+	}
+}
+
+// generateTransactionLog defines functions to append changes to the transaction log and track pending (not committed) state
+func generateTransactionLog(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("log" + model.Name).Params(jen.Id("entity").Op("*").Id(model.Name + "LogEntity")).BlockFunc(func(logFunc *jen.Group) {
+			logFunc.Id("project").Dot("_log").Op("=").Append(jen.Id("project").Dot("_log"), jen.Id(proj.Name+"LogEntity").Values(jen.Id(model.Name).Op(":").Id("entity")))
+			logFunc.Id("project").Dot("track" + model.Name).Call(jen.Id("entity"))
+		}).Line()
+		// pending state of item and its indexes
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("track" + model.Name).Params(jen.Id("entity").Op("*").Id(model.Name + "LogEntity")).BlockFunc(func(logFunc *jen.Group) {
+			logFunc.Id("project").Dot("_pending" + model.Name).Index(jen.Id("entity").Dot(model.Indexed)).Op("=").Id("entity")
+			indexes := modelIndexes(model)
+			if len(indexes) == 0 {
+				return
+			}
+			logFunc.If(jen.Id("entity").Dot("Action").Op("==").Id(proj.Name + "ActionDelete")).BlockFunc(func(removed *jen.Group) {
+				for _, index := range indexes {
+					removed.Id("project").Dot(index.PendingName()).Dot("remove").Call(jen.Id("entity").Dot(model.Indexed))
+				}
+			}).Else().BlockFunc(func(changed *jen.Group) {
+				for _, index := range indexes {
+					changed.Id("project").Dot(index.PendingName()).Dot("put").Call(jen.Id("entity").Dot(model.Indexed), jen.Id("entity").Dot("Item").Dot(index.Field))
+				}
+			})
+		}).Line()
+	}
+	// reset pending state
+	code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("resetPending").Params().BlockFunc(func(resetFunc *jen.Group) {
+		for _, model := range proj.Models {
+			resetFunc.Id("project").Dot("_pending" + model.Name).Op("=").Make(jen.Map(jen.Id(model.FieldType(model.Indexed))).Op("*").Id(model.Name + "LogEntity"))
+			for _, index := range modelIndexes(model) {
+				resetFunc.Id("project").Dot(index.PendingName()).Op("=").Id(index.Constructor()).Call()
+			}
+		}
+	}).Line()
+	return code
+}
+
 func generateTransactionalDefines(proj *memdata.Project) jen.Code {
 	var code = jen.Type().Id(proj.Name + "Action").Int().Line()
 	code.Const().DefsFunc(func(defines *jen.Group) {
@@ -505,3 +972,46 @@ func Generate(proj *memdata.Project) jen.Code {
 	}
 	return s
 }
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// generateStorageLink passes the project to storage in constructor: items decoded by storage (SQL, KV) refer to the
+// project
+func generateStorageLink(group *jen.Group, proj *memdata.Project, storage string) {
+	linked := jen.Interface(jen.Id("link" + proj.Name).Params(jen.Id(proj.Name + "Reader")))
+	group.If(jen.List(jen.Id("linked"), jen.Id("ok")).Op(":=").Id(storage).Assert(linked), jen.Id("ok")).Block(
+		jen.Id("linked").Dot("link" + proj.Name).Call(jen.Id("project")),
+	)
+}
+
+// generateStorageLinkMethod defines receiver of the project for items decoded by storage
+func generateStorageLinkMethod(code *jen.Statement, objName string, proj *memdata.Project) {
+	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("link" + proj.Name).Params(jen.Id("project").Id(proj.Name + "Reader")).Block(
+		jen.Id("storage").Dot("project").Op("=").Id("project"),
+	).Line()
+}
+
+// iteratorType is callback of Iterate<Model>: iteration stops when it returns false
+func iteratorType(model *memdata.Model) *jen.Statement {
+	return jen.Func().Params(jen.Id(memdata.ToLowerCamel(model.Indexed)).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool()
+}
+
+// seqType is range-over-func sequence of keys and items of model
+func seqType(model *memdata.Model) *jen.Statement {
+	return jen.Qual("iter", "Seq2").Index(jen.List(jen.Id(model.FieldType(model.Indexed)), jen.Op("*").Id(model.Name)))
+}
+
+// generateStorageIter defines Iter<Model> of storage: sequence over Iterate<Model>
+func generateStorageIter(code *jen.Statement, objName string, model *memdata.Model) {
+	code.Comment("Iter" + model.Name + " returns sequence of keys and items of " + model.Name + " in order of Iterate" + model.Name).Line()
+	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Iter" + model.Name).Params().Add(seqType(model)).Block(
+		jen.Return().Id("storage").Dot("Iterate" + model.Name),
+	).Line()
+}
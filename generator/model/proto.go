@@ -0,0 +1,408 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Protobuf messages of models have fields (links are keys) with numbers from proto map of models, so adding of
+// field doesn't change numbers of other fields.
+
+const (
+	protoTimestamp       = "google.protobuf.Timestamp"
+	protoTimestampImport = "google/protobuf/timestamp.proto"
+	timestampPackage     = "google.golang.org/protobuf/types/known/timestamppb"
+	protoMaxNumber       = 1<<29 - 1
+)
+
+// protoScalars maps builtin Go types to protobuf scalar types
+var protoScalars = map[string]string{
+	"bool":    "bool",
+	"string":  "string",
+	"[]byte":  "bytes",
+	"int":     "int64",
+	"int64":   "int64",
+	"int8":    "int32",
+	"int16":   "int32",
+	"int32":   "int32",
+	"rune":    "int32",
+	"uint":    "uint64",
+	"uint64":  "uint64",
+	"uint8":   "uint32",
+	"byte":    "uint32",
+	"uint16":  "uint32",
+	"uint32":  "uint32",
+	"float32": "float",
+	"float64": "double",
+}
+
+// protoGoTypes maps protobuf scalar types to Go types of protoc-gen-go
+var protoGoTypes = map[string]string{
+	"bool":   "bool",
+	"string": "string",
+	"bytes":  "[]byte",
+	"int64":  "int64",
+	"int32":  "int32",
+	"uint64": "uint64",
+	"uint32": "uint32",
+	"float":  "float32",
+	"double": "float64",
+}
+
+type protoField struct {
+	Name      string // name of field in proto file
+	Field     string // name of struct field of model
+	Type      string // type of struct field
+	ProtoType string // type of field in proto file
+	Number    int
+}
+
+// GoName is name of field in Go code of protoc-gen-go
+func (field protoField) GoName() string {
+	return protoGoName(field.Name)
+}
+
+// snakeCase converts name of Go field to name of proto field (ex: HTTPPort to http_port)
+func snakeCase(name string) string {
+	var out []byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if isUpper(c) {
+			if i > 0 && (!isUpper(name[i-1]) || (i+1 < len(name) && isLower(name[i+1]))) && name[i-1] != '_' {
+				out = append(out, '_')
+			}
+			c += 'a' - 'A'
+		}
+		out = append(out, c)
+	}
+	return string(out)
+}
+
+// protoGoName converts name of proto field to name of Go field by rules of protoc-gen-go
+func protoGoName(name string) string {
+	var out []byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_' && i == 0:
+			out = append(out, 'X')
+		case c == '_' && i+1 < len(name) && isLower(name[i+1]):
+			// skip underscore before lower letter
+		case c >= '0' && c <= '9':
+			out = append(out, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			out = append(out, c)
+			for ; i+1 < len(name) && isLower(name[i+1]); i++ {
+				out = append(out, name[i+1])
+			}
+		}
+	}
+	return string(out)
+}
+
+func isUpper(c byte) bool { return c >= 'A' && c <= 'Z' }
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
+
+// protoValueType returns protobuf type of single value or empty string if type is not supported
+func protoValueType(proj *memdata.Project, typeName string) string {
+	if scalar, ok := protoScalars[typeName]; ok {
+		return scalar
+	}
+	if isTimeType(proj, typeName) {
+		return protoTimestamp
+	}
+	return ""
+}
+
+// protoType returns type of field in proto file or empty string if type is not supported
+func protoType(proj *memdata.Project, typeName string) string {
+	switch {
+	case typeName == "[]byte":
+		return "bytes"
+	case strings.HasPrefix(typeName, "[]"):
+		if elem := protoValueType(proj, typeName[2:]); elem != "" {
+			return "repeated " + elem
+		}
+		return ""
+	case strings.HasPrefix(typeName, "map["):
+		key, value := splitMapType(typeName)
+		keyType, valueType := protoScalars[key], protoValueType(proj, value)
+		if keyType == "" || keyType == "float" || keyType == "double" || keyType == "bytes" || valueType == "" {
+			return ""
+		}
+		return "map<" + keyType + ", " + valueType + ">"
+	}
+	return protoValueType(proj, typeName)
+}
+
+// protoFields lists fields of message of model in order of numbers
+func protoFields(model *memdata.Model) []protoField {
+	var fields []protoField
+	for _, names := range []map[string]string{model.Fields, model.Ref, model.HasMany} {
+		for name := range names {
+			fieldName, fieldType := validatedField(model, name)
+			fields = append(fields, protoField{
+				Name:      snakeCase(fieldName),
+				Field:     fieldName,
+				Type:      fieldType,
+				ProtoType: protoType(model.Project, fieldType),
+				Number:    model.ProtoNumbers[name],
+			})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].Number != fields[j].Number {
+			return fields[i].Number < fields[j].Number
+		}
+		return fields[i].Field < fields[j].Field
+	})
+	return fields
+}
+
+// checkProtoNumbers checks that each field (or link) of model has own valid number of protobuf field
+func checkProtoNumbers(model *memdata.Model) {
+	numbered := make(map[int]string)
+	for _, name := range sortedProtoNumbers(model) {
+		path := model.Name + "." + name
+		_, isField := model.Fields[name]
+		_, isRef := model.Ref[name]
+		_, isMany := model.HasMany[name]
+		if !isField && !isRef && !isMany {
+			panic("proto number of unknown field " + path)
+		}
+		number := model.ProtoNumbers[name]
+		if number < 1 || number > protoMaxNumber || (number >= 19000 && number <= 19999) {
+			panic("invalid proto number " + strconv.Itoa(number) + " of " + path)
+		}
+		if other, ok := numbered[number]; ok {
+			panic("proto numbers of " + path + " and " + model.Name + "." + other + " are the same")
+		}
+		numbered[number] = name
+	}
+	for _, names := range []map[string]string{model.Fields, model.Ref, model.HasMany} {
+		for _, name := range sortedKeys(names) {
+			if _, ok := model.ProtoNumbers[name]; !ok {
+				panic("proto number of " + model.Name + "." + name + " is not set in proto of model")
+			}
+		}
+	}
+}
+
+func sortedProtoNumbers(model *memdata.Model) []string {
+	var names []string
+	for name := range model.ProtoNumbers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func protoPackage(proj *memdata.Project) string {
+	if proj.Proto.Package != "" {
+		return proj.Proto.Package
+	}
+	return proj.Package
+}
+
+func hasProtoTimestamps(proj *memdata.Project) bool {
+	for _, model := range proj.Models {
+		for _, field := range protoFields(model) {
+			if strings.Contains(field.ProtoType, protoTimestamp) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkProto checks that fields of models could be represented in protobuf
+func checkProto(proj *memdata.Project) {
+	for _, model := range proj.Models {
+		checkProtoNumbers(model)
+		seen := make(map[string]string)
+		for _, field := range protoFields(model) {
+			path := model.Name + "." + field.Field
+			if field.ProtoType == "" {
+				panic("proto requires number, bool, string, time.Time, slice or map field " + path)
+			}
+			if other, ok := seen[field.GoName()]; ok {
+				panic("proto names of " + path + " and " + model.Name + "." + other + " are the same")
+			}
+			seen[field.GoName()] = field.Field
+		}
+		if _, ok := protoScalars[model.FieldType(model.Indexed)]; proj.Proto.Service && !ok {
+			panic("proto service requires number, bool or string key of " + model.Name)
+		}
+	}
+}
+
+// GenerateProto renders proto file with messages of models and (optionally) gRPC service with CRUD over the project
+func GenerateProto(proj *memdata.Project) string {
+	prepareProject(proj)
+	if proj.Proto == nil {
+		proj.Proto = &memdata.Proto{}
+		checkProto(proj)
+	}
+	out := &strings.Builder{}
+	out.WriteString("// Code generated by memdata. DO NOT EDIT.\n\n")
+	out.WriteString("syntax = \"proto3\";\n\n")
+	out.WriteString("package " + protoPackage(proj) + ";\n\n")
+	if hasProtoTimestamps(proj) {
+		out.WriteString("import \"" + protoTimestampImport + "\";\n\n")
+	}
+	if proj.Proto.GoPackage != "" {
+		out.WriteString("option go_package = " + strconv.Quote(proj.Proto.GoPackage) + ";\n\n")
+	}
+	for i, model := range proj.Models {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		writeProtoMessage(out, model.Name, protoFields(model))
+	}
+	if !proj.Proto.Service {
+		return out.String()
+	}
+	out.WriteString("\nservice " + proj.Name + "Service {\n")
+	for _, model := range proj.Models {
+		out.WriteString("  rpc Get" + model.Name + "(Get" + model.Name + "Request) returns (" + model.Name + ");\n")
+		out.WriteString("  rpc List" + model.Name + "s(List" + model.Name + "sRequest) returns (List" + model.Name + "sResponse);\n")
+		out.WriteString("  rpc Insert" + model.Name + "(" + model.Name + ") returns (" + model.Name + ");\n")
+		out.WriteString("  rpc Update" + model.Name + "(" + model.Name + ") returns (" + model.Name + ");\n")
+		out.WriteString("  rpc Remove" + model.Name + "(Remove" + model.Name + "Request) returns (Remove" + model.Name + "Response);\n")
+	}
+	out.WriteString("}\n")
+	for _, model := range proj.Models {
+		keyType := model.FieldType(model.Indexed)
+		key := []protoField{{Name: snakeCase(model.Indexed), Field: model.Indexed, Type: keyType, ProtoType: protoType(proj, keyType), Number: 1}}
+		items := []protoField{{Name: "items", ProtoType: "repeated " + model.Name, Number: 1}}
+		out.WriteString("\n")
+		writeProtoMessage(out, "Get"+model.Name+"Request", key)
+		out.WriteString("\n")
+		writeProtoMessage(out, "List"+model.Name+"sRequest", nil)
+		out.WriteString("\n")
+		writeProtoMessage(out, "List"+model.Name+"sResponse", items)
+		out.WriteString("\n")
+		writeProtoMessage(out, "Remove"+model.Name+"Request", key)
+		out.WriteString("\n")
+		writeProtoMessage(out, "Remove"+model.Name+"Response", nil)
+	}
+	return out.String()
+}
+
+func writeProtoMessage(out *strings.Builder, name string, fields []protoField) {
+	if len(fields) == 0 {
+		out.WriteString("message " + name + " {}\n")
+		return
+	}
+	out.WriteString("message " + name + " {\n")
+	for _, field := range fields {
+		out.WriteString("  " + field.ProtoType + " " + field.Name + " = " + strconv.Itoa(field.Number) + ";\n")
+	}
+	out.WriteString("}\n")
+}
+
+// protoGoCode renders Go type of protobuf value in code of protoc-gen-go
+func protoGoCode(valueType string) jen.Code {
+	if valueType == protoTimestamp {
+		return jen.Op("*").Qual(timestampPackage, "Timestamp")
+	}
+	return jen.Id(protoGoTypes[valueType])
+}
+
+// toProtoValue converts single value of model to value of message
+func toProtoValue(proj *memdata.Project, value jen.Code, typeName string) jen.Code {
+	valueType := protoValueType(proj, typeName)
+	switch {
+	case valueType == protoTimestamp:
+		return jen.Id(memdata.ToLowerCamel(proj.Name) + "Timestamp").Call(value)
+	case protoGoTypes[valueType] == typeName:
+		return value
+	}
+	return jen.Id(protoGoTypes[valueType]).Call(value)
+}
+
+// fromProtoValue converts single value of message to value of model
+func fromProtoValue(proj *memdata.Project, value jen.Code, typeName string) jen.Code {
+	valueType := protoValueType(proj, typeName)
+	switch {
+	case valueType == protoTimestamp:
+		return jen.Id(memdata.ToLowerCamel(proj.Name) + "Time").Call(value)
+	case protoGoTypes[valueType] == typeName:
+		return value
+	}
+	return jen.Id(typeName).Call(value)
+}
+
+// generateConvertField copies field of source to field of target. Slices and maps are copied by elements.
+func generateConvertField(group *jen.Group, proj *memdata.Project, source, target *jen.Statement, typeName string, convert func(proj *memdata.Project, value jen.Code, typeName string) jen.Code, targetType func(key, value string) jen.Code) {
+	switch {
+	case typeName == "[]byte":
+		group.Add(target).Op("=").Add(source)
+	case strings.HasPrefix(typeName, "[]"):
+		group.For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Add(source)).Block(
+			target.Clone().Op("=").Append(target.Clone(), convert(proj, jen.Id("value"), typeName[2:])),
+		)
+	case strings.HasPrefix(typeName, "map["):
+		key, value := splitMapType(typeName)
+		group.If(source.Clone().Op("!=").Nil()).Block(
+			target.Clone().Op("=").Make(targetType(key, value), jen.Len(source.Clone())),
+			jen.For(jen.List(jen.Id("key"), jen.Id("value")).Op(":=").Range().Add(source.Clone())).Block(
+				target.Clone().Index(convert(proj, jen.Id("key"), key)).Op("=").Add(convert(proj, jen.Id("value"), value)),
+			),
+		)
+	default:
+		group.Add(target).Op("=").Add(convert(proj, source, typeName))
+	}
+}
+
+// generateProtoConversions defines conversion functions between models and messages generated by protoc-gen-go
+func generateProtoConversions(proj *memdata.Project) jen.Code {
+	pb := proj.Proto.GoPackage
+	code := jen.Line()
+	if hasProtoTimestamps(proj) {
+		// zero time is represented by absent timestamp
+		code.Func().Id(memdata.ToLowerCamel(proj.Name)+"Timestamp").Params(jen.Id("value").Qual("time", "Time")).Op("*").Qual(timestampPackage, "Timestamp").Block(
+			jen.If(jen.Id("value").Dot("IsZero").Call()).Block(jen.Return().Nil()),
+			jen.Return().Qual(timestampPackage, "New").Call(jen.Id("value")),
+		).Line()
+		code.Func().Id(memdata.ToLowerCamel(proj.Name)+"Time").Params(jen.Id("value").Op("*").Qual(timestampPackage, "Timestamp")).Qual("time", "Time").Block(
+			jen.If(jen.Id("value").Op("==").Nil()).Block(jen.Return().Qual("time", "Time").Values()),
+			jen.Return().Id("value").Dot("AsTime").Call(),
+		).Line()
+	}
+	protoMap := func(key, value string) jen.Code {
+		return jen.Map(protoGoCode(protoScalars[key])).Add(protoGoCode(protoValueType(proj, value)))
+	}
+	modelMap := func(key, value string) jen.Code {
+		return jen.Map(typeCode(proj, key)).Add(typeCode(proj, value))
+	}
+	for _, model := range proj.Models {
+		fields := protoFields(model)
+		code.Comment(model.Name + "ToProto converts item to protobuf message (nil for nil item)").Line()
+		code.Func().Id(model.Name+"ToProto").Params(jen.Id("item").Op("*").Id(model.Name)).Op("*").Qual(pb, model.Name).BlockFunc(func(toFunc *jen.Group) {
+			toFunc.If(jen.Id("item").Op("==").Nil()).Block(jen.Return().Nil())
+			toFunc.Id("message").Op(":=").Op("&").Qual(pb, model.Name).Values()
+			for _, field := range fields {
+				generateConvertField(toFunc, proj, jen.Id("item").Dot(field.Field), jen.Id("message").Dot(field.GoName()), field.Type, toProtoValue, protoMap)
+			}
+			toFunc.Return().Id("message")
+		}).Line()
+		code.Comment(model.Name + "FromProto converts protobuf message to item (nil for nil message)").Line()
+		code.Func().Id(model.Name + "FromProto").Params(jen.Id("message").Op("*").Qual(pb, model.Name)).Op("*").Id(model.Name).BlockFunc(func(fromFunc *jen.Group) {
+			fromFunc.If(jen.Id("message").Op("==").Nil()).Block(jen.Return().Nil())
+			fromFunc.Id("item").Op(":=").Op("&").Id(model.Name).Values()
+			for _, field := range fields {
+				generateConvertField(fromFunc, proj, jen.Id("message").Dot(field.GoName()), jen.Id("item").Dot(field.Field), field.Type, fromProtoValue, modelMap)
+			}
+			fromFunc.Return().Id("item")
+		}).Line()
+	}
+	return code
+}
@@ -0,0 +1,356 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"strings"
+)
+
+// queryField is field of model with predicates in queries
+type queryField struct {
+	Name    string // name of struct field
+	Type    string
+	Time    bool        // compared by methods of time.Time
+	Many    bool        // slice of keys of many-link (only Contains)
+	Index   *modelIndex // secondary index of field (lookup by value)
+	Key     bool        // key of model
+	Ordered bool        // field has order (comparisons and sort)
+}
+
+// queryFields lists fields of model which could be compared: numbers, strings, booleans, time and keys of links
+func queryFields(model *memdata.Model) []queryField {
+	proj := model.Project
+	indexes := make(map[string]modelIndex)
+	for _, index := range modelIndexes(model) {
+		indexes[index.Field] = index
+	}
+	var fields []queryField
+	for _, field := range binaryFields(model) {
+		result := queryField{Name: field.Name, Type: field.Type, Key: field.Name == model.Indexed}
+		if index, ok := indexes[field.Name]; ok {
+			result.Index = &index
+			result.Many = index.Many
+		}
+		switch {
+		case result.Many:
+			result.Type = strings.TrimPrefix(field.Type, "[]")
+		case isOrderedType(field.Type):
+			result.Ordered = true
+		case isTimeType(proj, field.Type):
+			result.Time = true
+			result.Ordered = true
+			// equal moments could be different values of map
+			result.Index = nil
+		case field.Type == "bool":
+		default:
+			continue
+		}
+		fields = append(fields, result)
+	}
+	return fields
+}
+
+// checkQuery checks that names of queries and fields in queries are not names of models
+func checkQuery(proj *memdata.Project) {
+	models := make(map[string]bool)
+	for _, model := range proj.Models {
+		models[model.Name] = true
+	}
+	for _, model := range proj.Models {
+		names := []string{model.Name + "s", model.Name + "Query", model.Name + "Condition", model.Name + "Order"}
+		for _, field := range queryFields(model) {
+			names = append(names, model.Name+field.Name)
+		}
+		for _, name := range names {
+			if models[name] {
+				panic("query name " + name + " of " + model.Name + " is name of model")
+			}
+		}
+	}
+}
+
+func queryFieldType(model *memdata.Model, field queryField) string {
+	return memdata.ToLowerCamel(model.Name) + field.Name + "Field"
+}
+
+// queryCompare compares value of item field with value by operator (==, !=, <, <=, >, >=)
+func queryCompare(field queryField, value jen.Code, op string, other jen.Code) jen.Code {
+	if !field.Time {
+		return jen.Add(value).Op(op).Add(other)
+	}
+	switch op {
+	case "==":
+		return jen.Add(value).Dot("Equal").Call(other)
+	case "!=":
+		return jen.Op("!").Add(value).Dot("Equal").Call(other)
+	case "<":
+		return jen.Add(value).Dot("Before").Call(other)
+	case "<=":
+		return jen.Op("!").Add(value).Dot("After").Call(other)
+	case ">":
+		return jen.Add(value).Dot("After").Call(other)
+	default:
+		return jen.Op("!").Add(value).Dot("Before").Call(other)
+	}
+}
+
+// generateQuery defines query builders of models, fields of models in queries and execution of queries
+func generateQuery(proj *memdata.Project) jen.Code {
+	code := jen.Line()
+	for _, model := range proj.Models {
+		code.Add(generateModelQuery(model))
+	}
+	return code
+}
+
+// generateQueryInterface adds query builders of models to the reader
+func generateQueryInterface(iface *jen.Group, proj *memdata.Project) {
+	for _, model := range proj.Models {
+		iface.Id(model.Name + "s").Params().Op("*").Id(model.Name + "Query")
+	}
+}
+
+func generateModelQuery(model *memdata.Model) jen.Code {
+	proj := model.Project
+	impl := "impl" + proj.Name
+	queryType := model.Name + "Query"
+	conditionType := model.Name + "Condition"
+	orderType := model.Name + "Order"
+	orderedKey := isOrderedType(model.FieldType(model.Indexed))
+	visitor := func() *jen.Statement { return jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool() }
+	recv := func() *jen.Statement { return jen.Params(jen.Id("query").Op("*").Id(queryType)) }
+
+	code := jen.Comment(conditionType + " is condition of " + queryType + " by field of " + model.Name + " (ex: " + model.Name + model.Indexed + ".Eq(key))").Line()
+	code.Type().Id(conditionType).Struct(
+		jen.Id("match").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool(),
+		// candidates from key or secondary index (nil for scan): lookups by value (rank 1) are preferred to ranges (rank 2)
+		jen.Id("lookup").Func().Params(jen.Id("project").Op("*").Id(impl), jen.Id("visit").Add(visitor())),
+		jen.Id("rank").Int(),
+	).Line()
+	code.Comment(orderType + " is order of " + queryType + " by field of " + model.Name + " (ex: " + model.Name + model.Indexed + ".Desc())").Line()
+	code.Type().Id(orderType).Struct(
+		jen.Id("less").Func().Params(jen.List(jen.Id("a"), jen.Id("b")).Op("*").Id(model.Name)).Bool(),
+		jen.Id("key").Bool(),
+		jen.Id("desc").Bool(),
+	).Line()
+	code.Comment(queryType + " selects items of " + model.Name + " by conditions in order of fields (and keys) with limit. Query is").Line()
+	code.Comment("immutable: Where, OrderBy and Limit return new query. Conditions by key and secondary indexes use them,").Line()
+	code.Comment("other queries scan all items.").Line()
+	code.Type().Id(queryType).Struct(
+		jen.Id("project").Op("*").Id(impl),
+		jen.Id("conditions").Index().Id(conditionType),
+		jen.Id("orders").Index().Id(orderType),
+		jen.Id("limit").Int(),
+	).Line()
+	code.Func().Parens(jen.Id("project").Op("*").Id(impl)).Id(model.Name + "s").Params().Op("*").Id(queryType).Block(
+		jen.Return().Op("&").Id(queryType).Values(jen.Id("project").Op(":").Id("project")),
+	).Line()
+	code.Comment("Where adds conditions: items should match all of them").Line()
+	code.Func().Add(recv()).Id("Where").Params(jen.Id("conditions").Op("...").Id(conditionType)).Op("*").Id(queryType).Block(
+		jen.Id("next").Op(":=").Op("*").Id("query"),
+		jen.Id("next").Dot("conditions").Op("=").Append(jen.Id("query").Dot("conditions").Index(jen.Op(":").Len(jen.Id("query").Dot("conditions")).Op(":").Len(jen.Id("query").Dot("conditions"))), jen.Id("conditions").Op("...")),
+		jen.Return().Op("&").Id("next"),
+	).Line()
+	code.Comment("OrderBy adds orders: next order compares items with equal fields of previous orders").Line()
+	code.Func().Add(recv()).Id("OrderBy").Params(jen.Id("orders").Op("...").Id(orderType)).Op("*").Id(queryType).Block(
+		jen.Id("next").Op(":=").Op("*").Id("query"),
+		jen.Id("next").Dot("orders").Op("=").Append(jen.Id("query").Dot("orders").Index(jen.Op(":").Len(jen.Id("query").Dot("orders")).Op(":").Len(jen.Id("query").Dot("orders"))), jen.Id("orders").Op("...")),
+		jen.Return().Op("&").Id("next"),
+	).Line()
+	code.Comment("Limit sets maximum number of items (0 is unlimited)").Line()
+	code.Func().Add(recv()).Id("Limit").Params(jen.Id("limit").Int()).Op("*").Id(queryType).Block(
+		jen.Id("next").Op(":=").Op("*").Id("query"),
+		jen.Id("next").Dot("limit").Op("=").Id("limit"),
+		jen.Return().Op("&").Id("next"),
+	).Line()
+	code.Comment("All returns matched items").Line()
+	code.Func().Add(recv()).Id("All").Params().Index().Op("*").Id(model.Name).Block(
+		jen.Return().Id("query").Dot("project").Dot("query" + model.Name).Call(jen.Id("query")),
+	).Line()
+	code.Comment("First returns the first matched item or nil").Line()
+	code.Func().Add(recv()).Id("First").Params().Op("*").Id(model.Name).Block(
+		jen.Id("items").Op(":=").Id("query").Dot("Limit").Call(jen.Lit(1)).Dot("All").Call(),
+		jen.If(jen.Len(jen.Id("items")).Op("==").Lit(0)).Block(jen.Return().Nil()),
+		jen.Return().Id("items").Index(jen.Lit(0)),
+	).Line()
+	code.Comment("Count returns number of matched items").Line()
+	code.Func().Add(recv()).Id("Count").Params().Int().Block(
+		jen.Return().Len(jen.Id("query").Dot("All").Call()),
+	).Line()
+
+	// execution: candidates from the best lookup, walk of ordered keys or scan
+	code.Func().Parens(jen.Id("project").Op("*").Id(impl)).Id("query" + model.Name).Params(jen.Id("query").Op("*").Id(queryType)).Index().Op("*").Id(model.Name).BlockFunc(func(queryFunc *jen.Group) {
+		generateReadLock(queryFunc, proj)
+		queryFunc.Var().Id("lookup").Op("*").Id(conditionType)
+		queryFunc.For(jen.Id("i").Op(":=").Range().Id("query").Dot("conditions")).Block(
+			jen.Id("condition").Op(":=").Op("&").Id("query").Dot("conditions").Index(jen.Id("i")),
+			jen.If(jen.Id("condition").Dot("lookup").Op("!=").Nil().Op("&&").Parens(jen.Id("lookup").Op("==").Nil().Op("||").Id("condition").Dot("rank").Op("<").Id("lookup").Dot("rank"))).Block(
+				jen.Id("lookup").Op("=").Id("condition"),
+			),
+		)
+		// items are visited in the final order: limit stops the walk
+		if orderedKey {
+			queryFunc.Id("walk").Op(":=").Id("lookup").Op("==").Nil().Op("&&").Parens(
+				jen.Len(jen.Id("query").Dot("orders")).Op("==").Lit(0).Op("||").Len(jen.Id("query").Dot("orders")).Op("==").Lit(1).Op("&&").Id("query").Dot("orders").Index(jen.Lit(0)).Dot("key"),
+			)
+		} else {
+			queryFunc.Id("walk").Op(":=").Id("lookup").Op("==").Nil().Op("&&").Len(jen.Id("query").Dot("orders")).Op("==").Lit(0)
+		}
+		queryFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+		queryFunc.Id("visit").Op(":=").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("condition")).Op(":=").Range().Id("query").Dot("conditions")).Block(
+				jen.If(jen.Op("!").Id("condition").Dot("match").Call(jen.Id("item"))).Block(jen.Return().True()),
+			),
+			jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+			jen.Return().Op("!").Id("walk").Op("||").Id("query").Dot("limit").Op("<=").Lit(0).Op("||").Len(jen.Id("items")).Op("<").Id("query").Dot("limit"),
+		)
+		queryFunc.Switch().BlockFunc(func(sw *jen.Group) {
+			sw.Case(jen.Id("lookup").Op("!=").Nil()).Block(
+				jen.Id("lookup").Dot("lookup").Call(jen.Id("project"), jen.Id("visit")),
+			)
+			if orderedKey {
+				sw.Case(jen.Id("walk").Op("&&").Len(jen.Id("query").Dot("orders")).Op("==").Lit(1).Op("&&").Id("query").Dot("orders").Index(jen.Lit(0)).Dot("desc")).Block(
+					jen.Id("project").Dot("descend"+model.Name).Call(jen.Nil(), jen.Id("visit")),
+				)
+				sw.Case(jen.Id("walk")).Block(
+					jen.Id("project").Dot("ascend"+model.Name).Call(jen.Nil(), jen.Id("visit")),
+				)
+			}
+			sw.Default().Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot("keys" + model.Name).Call()).Block(
+					jen.If(jen.Op("!").Id("visit").Call(visibleItem(model, jen.Id("key")))).Block(jen.Break()),
+				),
+			)
+		})
+		queryFunc.If(jen.Op("!").Id("walk")).Block(
+			jen.Qual("sort", "SliceStable").Call(jen.Id("items"), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().BlockFunc(func(lessFunc *jen.Group) {
+				lessFunc.For(jen.List(jen.Id("_"), jen.Id("order")).Op(":=").Range().Id("query").Dot("orders")).Block(
+					jen.If(jen.Id("order").Dot("less").Call(jen.Id("items").Index(jen.Id("i")), jen.Id("items").Index(jen.Id("j")))).Block(jen.Return().True()),
+					jen.If(jen.Id("order").Dot("less").Call(jen.Id("items").Index(jen.Id("j")), jen.Id("items").Index(jen.Id("i")))).Block(jen.Return().False()),
+				)
+				if orderedKey {
+					lessFunc.Return().Id("items").Index(jen.Id("i")).Dot(model.Indexed).Op("<").Id("items").Index(jen.Id("j")).Dot(model.Indexed)
+				} else {
+					lessFunc.Return().False()
+				}
+			})),
+		)
+		queryFunc.If(jen.Id("query").Dot("limit").Op(">").Lit(0).Op("&&").Len(jen.Id("items")).Op(">").Id("query").Dot("limit")).Block(
+			jen.Id("items").Op("=").Id("items").Index(jen.Empty(), jen.Id("query").Dot("limit")),
+		)
+		queryFunc.Return().Id("items")
+	}).Line()
+
+	for _, field := range queryFields(model) {
+		code.Add(generateQueryField(model, field))
+	}
+	return code
+}
+
+// generateQueryField defines field of model in queries: conditions and orders by the field
+func generateQueryField(model *memdata.Model, field queryField) jen.Code {
+	proj := model.Project
+	impl := "impl" + proj.Name
+	typeName := queryFieldType(model, field)
+	conditionType := model.Name + "Condition"
+	orderType := model.Name + "Order"
+	valueType := func() jen.Code { return proj.Qual(field.Type) }
+	value := func() *jen.Statement { return jen.Id("item").Dot(field.Name) }
+	matchFunc := func(body ...jen.Code) jen.Code {
+		return jen.Id("match").Op(":").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(body...)
+	}
+	lookupFunc := func(rank int, body ...jen.Code) []jen.Code {
+		return []jen.Code{
+			jen.Id("lookup").Op(":").Func().Params(jen.Id("project").Op("*").Id(impl), jen.Id("visit").Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool()).Block(body...),
+			jen.Id("rank").Op(":").Lit(rank),
+		}
+	}
+	// visit items by keys of secondary index
+	findKeys := func(value jen.Code) []jen.Code {
+		return lookupFunc(1, jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("project").Dot("find"+model.Name+"By"+field.Index.Name).Call(value)).Block(
+			jen.If(jen.Op("!").Id("visit").Call(visibleItem(model, jen.Id("key")))).Block(jen.Return()),
+		))
+	}
+	// visit items of ordered keys from the key (nil is the first) while condition of upper bound is true
+	ascendKeys := func(from jen.Code, bound jen.Code) []jen.Code {
+		visit := jen.Id("visit")
+		if bound != nil {
+			visit = jen.Func().Params(jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.Return().Add(bound).Op("&&").Id("visit").Call(jen.Id("item")),
+			)
+		}
+		return lookupFunc(2, jen.Id("project").Dot("ascend"+model.Name).Call(from, visit))
+	}
+	condition := func(method string, params []jen.Code, fields ...jen.Code) jen.Code {
+		return jen.Func().Params(jen.Id(typeName)).Id(method).Params(params...).Id(conditionType).Block(
+			jen.Return().Id(conditionType).Values(fields...),
+		).Line()
+	}
+
+	code := jen.Comment(model.Name + field.Name + " is field " + field.Name + " of " + model.Name + " in queries").Line()
+	code.Var().Id(model.Name + field.Name).Op("=").Id(typeName).Values().Line()
+	code.Type().Id(typeName).Struct().Line()
+	if field.Many {
+		fields := []jen.Code{matchFunc(
+			jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Add(value())).Block(
+				jen.If(jen.Id("key").Op("==").Id("value")).Block(jen.Return().True()),
+			),
+			jen.Return().False(),
+		)}
+		fields = append(fields, findKeys(jen.Id("value"))...)
+		code.Add(condition("Contains", []jen.Code{jen.Id("value").Add(valueType())}, fields...))
+		return code
+	}
+	ops := []struct{ method, op string }{{"Eq", "=="}, {"Ne", "!="}}
+	if field.Ordered {
+		ops = append(ops, []struct{ method, op string }{{"Lt", "<"}, {"Le", "<="}, {"Gt", ">"}, {"Ge", ">="}}...)
+	}
+	orderedKey := field.Key && !field.Time && field.Ordered
+	for _, op := range ops {
+		fields := []jen.Code{matchFunc(jen.Return().Add(queryCompare(field, value(), op.op, jen.Id("value"))))}
+		switch {
+		case op.method == "Eq" && field.Key:
+			fields = append(fields, lookupFunc(1, jen.If(jen.Id("item").Op(":=").Add(visibleItem(model, jen.Id("value"))), jen.Id("item").Op("!=").Nil()).Block(
+				jen.Id("visit").Call(jen.Id("item")),
+			))...)
+		case op.method == "Eq" && field.Index != nil:
+			fields = append(fields, findKeys(jen.Id("value"))...)
+		case orderedKey && (op.method == "Gt" || op.method == "Ge"):
+			fields = append(fields, ascendKeys(jen.Op("&").Id("value"), nil)...)
+		case orderedKey && (op.method == "Lt" || op.method == "Le"):
+			fields = append(fields, ascendKeys(jen.Nil(), queryCompare(field, value(), op.op, jen.Id("value")))...)
+		}
+		code.Add(condition(op.method, []jen.Code{jen.Id("value").Add(valueType())}, fields...))
+	}
+	if !field.Ordered {
+		return code
+	}
+	// range [from, to)
+	between := []jen.Code{matchFunc(jen.Return().Add(queryCompare(field, value(), ">=", jen.Id("from"))).Op("&&").Add(queryCompare(field, value(), "<", jen.Id("to"))))}
+	switch {
+	case orderedKey:
+		between = append(between, ascendKeys(jen.Op("&").Id("from"), queryCompare(field, value(), "<", jen.Id("to")))...)
+	case field.Index != nil && field.Index.Ordered:
+		between = append(between, lookupFunc(2, jen.Id("project").Dot("range"+model.Name+"By"+field.Index.Name).Call(jen.Id("from"), jen.Id("to"), jen.Id("visit")))...)
+	}
+	code.Add(condition("Between", []jen.Code{jen.List(jen.Id("from"), jen.Id("to")).Add(valueType())}, between...))
+	for _, order := range []string{"Asc", "Desc"} {
+		a, b := jen.Id("a"), jen.Id("b")
+		if order == "Desc" {
+			a, b = b, a
+		}
+		code.Func().Params(jen.Id(typeName)).Id(order).Params().Id(orderType).BlockFunc(func(orderFunc *jen.Group) {
+			fields := []jen.Code{jen.Id("less").Op(":").Func().Params(jen.List(jen.Id("a"), jen.Id("b")).Op("*").Id(model.Name)).Bool().Block(
+				jen.Return().Add(queryCompare(field, a.Clone().Dot(field.Name), "<", b.Clone().Dot(field.Name))),
+			)}
+			if orderedKey {
+				fields = append(fields, jen.Id("key").Op(":").True())
+				if order == "Desc" {
+					fields = append(fields, jen.Id("desc").Op(":").True())
+				}
+			}
+			orderFunc.Return().Id(orderType).Values(fields...)
+		}).Line()
+	}
+	return code
+}
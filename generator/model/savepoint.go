@@ -0,0 +1,92 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// generateSavepointInterface adds savepoints and nested transactions to the read-write transaction
+func generateSavepointInterface(iface *jen.Group, proj *memdata.Project) {
+	iface.Id("Savepoint").Params().Int()
+	iface.Id("RollbackTo").Params(jen.Id("savepoint").Int())
+	iface.Id("Begin").Params().Id(proj.Name + "ReadWriterTx")
+}
+
+// generateSavepoints defines savepoints (length of transaction log and values of sequences) and nested transactions
+// over savepoints
+func generateSavepoints(proj *memdata.Project) jen.Code {
+	typeName := memdata.ToLowerCamel(proj.Name) + "Savepoint"
+	nestedType := memdata.ToLowerCamel(proj.Name) + "NestedTx"
+	implType := "impl" + proj.Name
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("project").Op("*").Id(implType)) }
+	code := jen.Type().Id(typeName).StructFunc(func(st *jen.Group) {
+		st.Id("log").Int()
+		for _, model := range proj.Models {
+			for _, field := range model.AutoSequence {
+				st.Id("sequence" + model.Name + field).Int64()
+			}
+		}
+	}).Line()
+	code.Func().Add(recv()).Id("Savepoint").Params().Int().BlockFunc(func(saveFunc *jen.Group) {
+		saveFunc.Id("project").Dot("_savepoints").Op("=").Append(jen.Id("project").Dot("_savepoints"), jen.Id(typeName).ValuesFunc(func(fv *jen.Group) {
+			fv.Id("log").Op(":").Len(jen.Id("project").Dot("_log"))
+			for _, model := range proj.Models {
+				for _, field := range model.AutoSequence {
+					fv.Id("sequence" + model.Name + field).Op(":").Id("project").Dot("sequence" + model.Name + field)
+				}
+			}
+		}))
+		saveFunc.Return().Len(jen.Id("project").Dot("_savepoints")).Op("-").Lit(1)
+	}).Line()
+	// rollback drops changes and savepoints after the savepoint and rebuilds pending state from the rest of log. Unknown
+	// savepoint (released or never returned by Savepoint) is ignored
+	code.Func().Add(recv()).Id("RollbackTo").Params(jen.Id("savepoint").Int()).BlockFunc(func(rollbackFunc *jen.Group) {
+		rollbackFunc.If(jen.Id("savepoint").Op("<").Lit(0).Op("||").Id("savepoint").Op(">=").Len(jen.Id("project").Dot("_savepoints"))).Block(jen.Return())
+		rollbackFunc.Id("state").Op(":=").Id("project").Dot("_savepoints").Index(jen.Id("savepoint"))
+		rollbackFunc.Id("project").Dot("_savepoints").Op("=").Id("project").Dot("_savepoints").Index(jen.Empty(), jen.Id("savepoint").Op("+").Lit(1))
+		rollbackFunc.Id("project").Dot("_log").Op("=").Id("project").Dot("_log").Index(jen.Empty(), jen.Id("state").Dot("log"))
+		for _, model := range proj.Models {
+			for _, field := range model.AutoSequence {
+				rollbackFunc.Id("project").Dot("sequence" + model.Name + field).Op("=").Id("state").Dot("sequence" + model.Name + field)
+			}
+		}
+		rollbackFunc.Id("project").Dot("resetPending").Call()
+		rollbackFunc.For(jen.List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("project").Dot("_log")).BlockFunc(func(iter *jen.Group) {
+			for _, model := range proj.Models {
+				iter.If(jen.Id("tx").Dot(model.Name).Op("!=").Nil()).Block(
+					jen.Id("project").Dot("track" + model.Name).Call(jen.Id("tx").Dot(model.Name)),
+				)
+			}
+		})
+	}).Line()
+	code.Func().Add(recv()).Id("Begin").Params().Id(proj.Name + "ReadWriterTx").Block(
+		jen.Return().Op("&").Id(nestedType).Values(jen.Id(implType).Op(":").Id("project"), jen.Id("savepoint").Op(":").Id("project").Dot("Savepoint").Call()),
+	).Line()
+	// nested transaction: commit keeps changes in parent, discard rolls back to the savepoint. Finished (committed or
+	// discarded) transaction ignores next Commit and Discard, so Discard could be deferred
+	code.Type().Id(nestedType).Struct(
+		jen.Op("*").Id(implType),
+		jen.Id("savepoint").Int(),
+		jen.Id("done").Bool(),
+	).Line()
+	code.Func().Params(jen.Id("tx").Op("*").Id(nestedType)).Id("Commit").Params(withContext(proj)...).ParamsFunc(func(results *jen.Group) {
+		if commitError(proj) {
+			results.Error()
+		}
+	}).BlockFunc(func(commitFunc *jen.Group) {
+		commitFunc.If(jen.Op("!").Id("tx").Dot("done")).Block(
+			jen.Id("tx").Dot("done").Op("=").True(),
+			jen.Id("tx").Dot("_savepoints").Op("=").Id("tx").Dot("_savepoints").Index(jen.Empty(), jen.Id("tx").Dot("savepoint")),
+		)
+		if commitError(proj) {
+			commitFunc.Return().Nil()
+		}
+	}).Line()
+	code.Func().Params(jen.Id("tx").Op("*").Id(nestedType)).Id("Discard").Params().Block(
+		jen.If(jen.Id("tx").Dot("done")).Block(jen.Return()),
+		jen.Id("tx").Dot("done").Op("=").True(),
+		jen.Id("tx").Dot("RollbackTo").Call(jen.Id("tx").Dot("savepoint")),
+		jen.Id("tx").Dot("_savepoints").Op("=").Id("tx").Dot("_savepoints").Index(jen.Empty(), jen.Id("tx").Dot("savepoint")),
+	).Line()
+	return code
+}
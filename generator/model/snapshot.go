@@ -0,0 +1,268 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+const snapshotVersion = 2
+
+func snapshotType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "Snapshot"
+}
+
+// schemaFingerprint is hash of models, keys and fields (names and types) of the project: snapshot of the same
+// project with other fields couldn't be decoded correctly
+func schemaFingerprint(proj *memdata.Project) uint64 {
+	hash := sha256.New()
+	for _, model := range proj.Models {
+		fmt.Fprintf(hash, "model %s %s\n", model.Name, model.Indexed)
+		for _, field := range binaryFields(model) {
+			fmt.Fprintf(hash, "field %s %s\n", field.Name, field.Type)
+		}
+	}
+	return binary.BigEndian.Uint64(hash.Sum(nil))
+}
+
+// generateSnapshotInterface adds persistence of snapshots to the project main interface
+func generateSnapshotInterface(iface *jen.Group) {
+	iface.Id("Save").Params(jen.Id("writer").Qual("io", "Writer")).Error()
+	iface.Id("Load").Params(jen.Id("reader").Qual("io", "Reader")).Error()
+}
+
+// generateSnapshot defines versioned binary format of snapshot (magic, version, schema fingerprint and gob-encoded
+// content) and
+// Save/Load of the project
+func generateSnapshot(proj *memdata.Project) jen.Code {
+	typeName := snapshotType(proj)
+	magic := memdata.ToLowerCamel(proj.Name) + "SnapshotMagic"
+	version := memdata.ToLowerCamel(proj.Name) + "SnapshotVersion"
+	schema := memdata.ToLowerCamel(proj.Name) + "SnapshotSchema"
+	code := jen.Const().Defs(
+		jen.Id(magic).Op("=").Lit("memdata:"+proj.Name),
+		jen.Id(version).Op("=").Lit(snapshotVersion),
+		jen.Id(schema).Op("=").Uint64().Call(jen.Op(fmt.Sprintf("%#016x", schemaFingerprint(proj)))),
+	).Line()
+	// snapshot content: sequences and all items of all models
+	code.Type().Id(typeName).StructFunc(func(st *jen.Group) {
+		for _, model := range proj.Models {
+			for _, field := range model.AutoSequence {
+				st.Id("Sequence" + model.Name + field).Int64()
+			}
+		}
+		for _, model := range proj.Models {
+			st.Id(model.Name).Index().Op("*").Id(model.Name)
+		}
+	}).Line()
+	// write header and content
+	code.Func().Id("write"+proj.Name+"Snapshot").Params(jen.Id("writer").Qual("io", "Writer"), jen.Id("snapshot").Op("*").Id(typeName)).Error().BlockFunc(func(writeFunc *jen.Group) {
+		writeFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Qual("io", "WriteString").Call(jen.Id("writer"), jen.Id(magic)), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		writeFunc.If(jen.Err().Op(":=").Qual("encoding/binary", "Write").Call(jen.Id("writer"), jen.Qual("encoding/binary", "BigEndian"), jen.Uint32().Call(jen.Id(version))), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		writeFunc.If(jen.Err().Op(":=").Qual("encoding/binary", "Write").Call(jen.Id("writer"), jen.Qual("encoding/binary", "BigEndian"), jen.Uint64().Call(jen.Id(schema))), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		writeFunc.Return().Qual("encoding/gob", "NewEncoder").Call(jen.Id("writer")).Dot("Encode").Call(jen.Id("snapshot"))
+	}).Line()
+	// read and check header, read content
+	code.Func().Id("read"+proj.Name+"Snapshot").Params(jen.Id("reader").Qual("io", "Reader")).Params(jen.Op("*").Id(typeName), jen.Error()).BlockFunc(func(readFunc *jen.Group) {
+		readFunc.Id("header").Op(":=").Make(jen.Index().Byte(), jen.Len(jen.Id(magic)))
+		readFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Qual("io", "ReadFull").Call(jen.Id("reader"), jen.Id("header")), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		readFunc.If(jen.String().Call(jen.Id("header")).Op("!=").Id(magic)).Block(
+			jen.Return(jen.Nil(), jen.Qual("errors", "New").Call(jen.Lit("not a snapshot of "+proj.Name))),
+		)
+		readFunc.Var().Id("version").Uint32()
+		readFunc.If(jen.Err().Op(":=").Qual("encoding/binary", "Read").Call(jen.Id("reader"), jen.Qual("encoding/binary", "BigEndian"), jen.Op("&").Id("version")), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		readFunc.If(jen.Id("version").Op("!=").Id(version)).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("unsupported snapshot version %d"), jen.Id("version"))),
+		)
+		readFunc.Var().Id("schema").Uint64()
+		readFunc.If(jen.Err().Op(":=").Qual("encoding/binary", "Read").Call(jen.Id("reader"), jen.Qual("encoding/binary", "BigEndian"), jen.Op("&").Id("schema")), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		readFunc.If(jen.Id("schema").Op("!=").Id(schema)).Block(
+			jen.Return(jen.Nil(), jen.Qual("errors", "New").Call(jen.Lit("snapshot was made by other schema of "+proj.Name))),
+		)
+		readFunc.Var().Id("snapshot").Id(typeName)
+		readFunc.If(jen.Err().Op(":=").Qual("encoding/gob", "NewDecoder").Call(jen.Id("reader")).Dot("Decode").Call(jen.Op("&").Id("snapshot")), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		readFunc.Return(jen.Op("&").Id("snapshot"), jen.Nil())
+	}).Line()
+	if proj.Transactional {
+		code.Add(generateStorageSnapshot(proj))
+	}
+	code.Add(generateProjectSnapshot(proj))
+	return code
+}
+
+// generateStorageSnapshot defines Save/Load of transactional storage (without sequences)
+func generateStorageSnapshot(proj *memdata.Project) jen.Code {
+	typeName := snapshotType(proj)
+	storageType := jen.Id(proj.Name + "TxStorage")
+	// collect all items
+	code := jen.Func().Id(memdata.ToLowerCamel(proj.Name) + "StorageSnapshot").Params(jen.Id("storage").Add(storageType)).Op("*").Id(typeName).BlockFunc(func(collectFunc *jen.Group) {
+		collectFunc.Id("snapshot").Op(":=").Op("&").Id(typeName).Values()
+		for _, model := range proj.Models {
+			keyName := memdata.ToLowerCamel(model.Indexed)
+			collectFunc.Id("storage").Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.Id("snapshot").Dot(model.Name).Op("=").Append(jen.Id("snapshot").Dot(model.Name), jen.Id("item")),
+				jen.Return().True(),
+			))
+		}
+		collectFunc.Return().Id("snapshot")
+	}).Line()
+	// batch to replace content of storage by snapshot
+	code.Func().Id(memdata.ToLowerCamel(proj.Name)+"SnapshotBatch").Params(jen.Id("storage").Add(storageType), jen.Id("snapshot").Op("*").Id(typeName)).Index().Id(proj.Name + "LogEntity").BlockFunc(func(batchFunc *jen.Group) {
+		batchFunc.Var().Id("batch").Index().Id(proj.Name + "LogEntity")
+		for _, model := range proj.Models {
+			keyName := memdata.ToLowerCamel(model.Indexed)
+			batchFunc.Id("storage").Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.Id("batch").Op("=").Append(jen.Id("batch"), jen.Id(proj.Name+"LogEntity").Values(jen.Id(model.Name).Op(":").Op("&").Id(model.Name+"LogEntity").Values(
+					jen.Id(model.Indexed).Op(":").Id(keyName),
+					jen.Id("Action").Op(":").Id(proj.Name+"ActionDelete"),
+				))),
+				jen.Return().True(),
+			))
+		}
+		for _, model := range proj.Models {
+			batchFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("snapshot").Dot(model.Name)).Block(
+				jen.Id("batch").Op("=").Append(jen.Id("batch"), jen.Id(proj.Name+"LogEntity").Values(jen.Id(model.Name).Op(":").Op("&").Id(model.Name+"LogEntity").Values(
+					jen.Id(model.Indexed).Op(":").Id("item").Dot(model.Indexed),
+					jen.Id("Item").Op(":").Op("*").Id("item"),
+					jen.Id("Action").Op(":").Id(proj.Name+"ActionInsert"),
+				))),
+			)
+		}
+		batchFunc.Return().Id("batch")
+	}).Line()
+	code.Comment("Save" + proj.Name + "Storage writes snapshot of all items in storage").Line()
+	code.Func().Id("Save"+proj.Name+"Storage").Params(jen.Id("storage").Add(storageType), jen.Id("writer").Qual("io", "Writer")).Error().Block(
+		jen.Return().Id("write"+proj.Name+"Snapshot").Call(jen.Id("writer"), jen.Id(memdata.ToLowerCamel(proj.Name)+"StorageSnapshot").Call(jen.Id("storage"))),
+	).Line()
+	code.Comment("Load" + proj.Name + "Storage replaces all items in storage by items from snapshot").Line()
+	code.Func().Id("Load"+proj.Name+"Storage").Params(jen.Id("storage").Add(storageType), jen.Id("reader").Qual("io", "Reader")).Error().BlockFunc(func(loadFunc *jen.Group) {
+		loadFunc.List(jen.Id("snapshot"), jen.Err()).Op(":=").Id("read" + proj.Name + "Snapshot").Call(jen.Id("reader"))
+		loadFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		apply := jen.Id("storage").Dot("Apply").Call(contextArg(proj, jen.Id(memdata.ToLowerCamel(proj.Name)+"SnapshotBatch").Call(jen.Id("storage"), jen.Id("snapshot")))...)
+		if proj.Errors {
+			loadFunc.Id("ctx").Op(":=").Qual("context", "Background").Call()
+			loadFunc.Return().Add(apply)
+			return
+		}
+		loadFunc.Add(apply)
+		loadFunc.Return().Nil()
+	}).Line()
+	return code
+}
+
+// generateProjectSnapshot defines Save/Load of all items and sequences of the project
+func generateProjectSnapshot(proj *memdata.Project) jen.Code {
+	typeName := snapshotType(proj)
+	code := jen.Line()
+	code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Save").Params(jen.Id("writer").Qual("io", "Writer")).Error().BlockFunc(func(saveFunc *jen.Group) {
+		if proj.Transactional {
+			saveFunc.Id("project").Dot("_tx").Dot("RLock").Call()
+			saveFunc.Defer().Id("project").Dot("_tx").Dot("RUnlock").Call()
+			saveFunc.Id("snapshot").Op(":=").Id(memdata.ToLowerCamel(proj.Name) + "StorageSnapshot").Call(jen.Id("project").Dot("storage"))
+		} else {
+			if proj.Synchronized {
+				saveFunc.Id("project").Dot("_lock").Dot("RLock").Call()
+				saveFunc.Defer().Id("project").Dot("_lock").Dot("RUnlock").Call()
+			}
+			saveFunc.Id("snapshot").Op(":=").Op("&").Id(typeName).Values()
+			for _, model := range proj.Models {
+				keyName := memdata.ToLowerCamel(model.Indexed)
+				saveFunc.Id("project").Dot("index" + model.Name + "By" + model.Indexed).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Id(model.FieldType(model.Indexed)), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+					jen.Id("snapshot").Dot(model.Name).Op("=").Append(jen.Id("snapshot").Dot(model.Name), jen.Id("item")),
+					jen.Return().True(),
+				))
+			}
+		}
+		for _, model := range proj.Models {
+			for _, field := range model.AutoSequence {
+				sequence := jen.Id("project").Dot("sequence" + model.Name + field)
+				if proj.Synchronized {
+					saveFunc.Id("snapshot").Dot("Sequence"+model.Name+field).Op("=").Qual("sync/atomic", "LoadInt64").Call(jen.Op("&").Add(sequence))
+				} else {
+					saveFunc.Id("snapshot").Dot("Sequence" + model.Name + field).Op("=").Add(sequence)
+				}
+			}
+		}
+		saveFunc.Return().Id("write"+proj.Name+"Snapshot").Call(jen.Id("writer"), jen.Id("snapshot"))
+	}).Line()
+	code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Load").Params(jen.Id("reader").Qual("io", "Reader")).Error().BlockFunc(func(loadFunc *jen.Group) {
+		loadFunc.List(jen.Id("snapshot"), jen.Err()).Op(":=").Id("read" + proj.Name + "Snapshot").Call(jen.Id("reader"))
+		loadFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		if proj.Errors {
+			// writes of storage are not bound to a caller
+			loadFunc.Id("ctx").Op(":=").Qual("context", "Background").Call()
+		}
+		if proj.Transactional {
+			if proj.Events {
+				// handlers are called after unlock
+				loadFunc.Defer().Id("project").Dot("drain").Call()
+			}
+			loadFunc.Id("project").Dot("_tx").Dot("Lock").Call()
+			loadFunc.Defer().Id("project").Dot("_tx").Dot("Unlock").Call()
+		} else {
+			generateWriteLock(loadFunc, proj)
+		}
+		for _, model := range proj.Models {
+			loadFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("snapshot").Dot(model.Name)).Block(
+				jen.Id("item").Dot("_project").Op("=").Id("project"),
+			)
+		}
+		if proj.Transactional {
+			loadFunc.Id("batch").Op(":=").Id(memdata.ToLowerCamel(proj.Name)+"SnapshotBatch").Call(jen.Id("project").Dot("storage"), jen.Id("snapshot"))
+			if proj.Events {
+				loadFunc.Id("events").Op(":=").Id("project").Dot("collectEvents").Call(jen.Id("batch"))
+			}
+			generateApplyBatch(loadFunc, proj, jen.Id("batch"))
+			if proj.Events {
+				loadFunc.Id("project").Dot("enqueue").Call(jen.Id("events"))
+			}
+		} else {
+			for _, model := range proj.Models {
+				replace := jen.Id("project").Dot("replace" + model.Name).Call(contextArg(proj, jen.Id("snapshot").Dot(model.Name))...)
+				generateStorageWrite(loadFunc, proj, replace)
+			}
+		}
+		// restore sequences: saved value or maximum of loaded items (for snapshots without sequences)
+		for _, model := range proj.Models {
+			for _, field := range model.AutoSequence {
+				varName := "max" + field + "Of" + model.Name
+				loadFunc.Id(varName).Op(":=").Id("snapshot").Dot("Sequence" + model.Name + field)
+				loadFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("snapshot").Dot(model.Name)).Block(
+					jen.If(jen.Id("item").Dot(field).Op(">").Id(varName)).Block(jen.Id(varName).Op("=").Id("item").Dot(field)),
+				)
+				sequence := jen.Id("project").Dot("sequence" + model.Name + field)
+				if proj.Synchronized {
+					loadFunc.Qual("sync/atomic", "StoreInt64").Call(jen.Op("&").Add(sequence), jen.Id(varName))
+				} else {
+					loadFunc.Add(sequence).Op("=").Id(varName)
+				}
+			}
+		}
+		loadFunc.Return().Nil()
+	}).Line()
+	if proj.Transactional {
+		return code
+	}
+	// replace all items of model in storage and indexes
+	for _, model := range proj.Models {
+		keyName := memdata.ToLowerCamel(model.Indexed)
+		keyType := jen.Id(model.FieldType(model.Indexed))
+		code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("replace" + model.Name).Params(withContext(proj, jen.Id("items").Index().Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(replaceFunc *jen.Group) {
+			replaceFunc.Var().Id("keys").Index().Add(keyType)
+			replaceFunc.Id("project").Dot("index" + model.Name + "By" + model.Indexed).Dot("Iterate" + model.Name).Call(jen.Func().Params(jen.Id(keyName).Add(keyType), jen.Id("item").Op("*").Id(model.Name)).Bool().Block(
+				jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id(keyName)),
+				jen.Return().True(),
+			))
+			replaceFunc.For(jen.List(jen.Id("_"), jen.Id(keyName)).Op(":=").Range().Id("keys")).BlockFunc(func(iter *jen.Group) {
+				generateWrite(iter, model, "Delete")
+			})
+			replaceFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).BlockFunc(func(iter *jen.Group) {
+				generateWrite(iter, model, "Insert")
+			})
+			generateStorageResult(replaceFunc, proj)
+		}).Line()
+	}
+	return code
+}
@@ -0,0 +1,519 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"strconv"
+	"strings"
+)
+
+// Storage over database/sql keeps each model in table (snake case of model name) with column per field (snake case of
+// field name, links are keys) and many-links in join tables <table>_<link> with owner key, position and target key.
+// Slices, maps, pointers and other types are stored as JSON text.
+
+const (
+	dialectSQLite   = "sqlite"
+	dialectPostgres = "postgres"
+	dialectMySQL    = "mysql"
+)
+
+func sqlDialect(proj *memdata.Project) string {
+	if proj.SQL == nil || proj.SQL.Dialect == "" {
+		return dialectSQLite
+	}
+	return proj.SQL.Dialect
+}
+
+// sqlQuote quotes name of table or column
+func sqlQuote(proj *memdata.Project, name string) string {
+	if sqlDialect(proj) == dialectMySQL {
+		return "`" + name + "`"
+	}
+	return `"` + name + `"`
+}
+
+// sqlPlaceholder is placeholder of n-th (from 1) argument of statement
+func sqlPlaceholder(proj *memdata.Project, n int) string {
+	if sqlDialect(proj) == dialectPostgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+func sqlTable(model *memdata.Model) string {
+	return snakeCase(model.Name)
+}
+
+func sqlLinkTable(model *memdata.Model, link modelLink) string {
+	return snakeCase(model.Name) + "_" + snakeCase(link.Name)
+}
+
+func sqlExecutorType(proj *memdata.Project) string {
+	return memdata.ToLowerCamel(proj.Name) + "SQLExecutor"
+}
+
+type sqlColumn struct {
+	Name  string // name of column
+	Field string // name of struct field
+	Type  string // type of struct field
+	JSON  bool   // value is stored as JSON text
+}
+
+// sqlScalars are types stored in columns as is
+var sqlScalars = map[string]bool{
+	"bool": true, "string": true, "[]byte": true, "float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true, "rune": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "byte": true,
+}
+
+// sqlColumns lists columns of model table: key first, other fields and keys of ref links in order of names
+func sqlColumns(model *memdata.Model) []sqlColumn {
+	many := make(map[string]bool)
+	for _, link := range modelLinks(model) {
+		if link.Many {
+			many[link.Field] = true
+		}
+	}
+	columns := []sqlColumn{{Name: snakeCase(model.Indexed), Field: model.Indexed, Type: model.FieldType(model.Indexed)}}
+	for _, field := range binaryFields(model) {
+		if field.Name == model.Indexed || many[field.Name] {
+			continue
+		}
+		json := !sqlScalars[field.Type] && !isTimeType(model.Project, field.Type)
+		columns = append(columns, sqlColumn{Name: snakeCase(field.Name), Field: field.Name, Type: field.Type, JSON: json})
+	}
+	return columns
+}
+
+// sqlType is type of column in DDL
+func sqlType(proj *memdata.Project, column sqlColumn, key bool) string {
+	dialect := sqlDialect(proj)
+	switch {
+	case column.JSON:
+		return "TEXT"
+	case isTimeType(proj, column.Type):
+		switch dialect {
+		case dialectPostgres:
+			return "TIMESTAMP WITH TIME ZONE"
+		case dialectMySQL:
+			return "DATETIME(6)"
+		}
+		return "TIMESTAMP"
+	}
+	switch column.Type {
+	case "bool":
+		return "BOOLEAN"
+	case "string":
+		if key && dialect == dialectMySQL {
+			return "VARCHAR(255)"
+		}
+		return "TEXT"
+	case "[]byte":
+		if dialect == dialectPostgres {
+			return "BYTEA"
+		}
+		return "BLOB"
+	case "float32", "float64":
+		if dialect == dialectMySQL {
+			return "DOUBLE"
+		}
+		return "DOUBLE PRECISION"
+	case "int8", "int16", "int32", "rune", "uint8", "byte", "uint16":
+		return "INTEGER"
+	}
+	return "BIGINT"
+}
+
+// checkSQL checks dialect and that keys of models could be stored in columns
+func checkSQL(proj *memdata.Project) {
+	switch sqlDialect(proj) {
+	case dialectSQLite, dialectPostgres, dialectMySQL:
+	default:
+		panic("unknown SQL dialect " + proj.SQL.Dialect)
+	}
+	for _, model := range proj.Models {
+		if !isOrderedType(model.FieldType(model.Indexed)) {
+			panic("sql storage requires number or string key of " + model.Name)
+		}
+	}
+}
+
+// sqlSchema is DDL statements of tables of models and join tables of many-links
+func sqlSchema(proj *memdata.Project) []string {
+	quote := func(name string) string { return sqlQuote(proj, name) }
+	var statements []string
+	for _, model := range proj.Models {
+		var defs []string
+		for i, column := range sqlColumns(model) {
+			def := "  " + quote(column.Name) + " " + sqlType(proj, column, i == 0) + " NOT NULL"
+			if i == 0 {
+				def += " PRIMARY KEY"
+			}
+			defs = append(defs, def)
+		}
+		statements = append(statements, "CREATE TABLE "+quote(sqlTable(model))+" (\n"+strings.Join(defs, ",\n")+"\n)")
+		for _, link := range modelLinks(model) {
+			if !link.Many {
+				continue
+			}
+			owner := sqlColumn{Type: model.FieldType(model.Indexed)}
+			target := sqlColumn{Type: link.Target.FieldType(link.Target.Indexed)}
+			statements = append(statements, "CREATE TABLE "+quote(sqlLinkTable(model, link))+" (\n"+
+				"  "+quote("owner")+" "+sqlType(proj, owner, true)+" NOT NULL,\n"+
+				"  "+quote("position")+" INTEGER NOT NULL,\n"+
+				"  "+quote("target")+" "+sqlType(proj, target, false)+" NOT NULL,\n"+
+				"  PRIMARY KEY ("+quote("owner")+", "+quote("position")+")\n)")
+		}
+	}
+	return statements
+}
+
+// GenerateSQLSchema renders DDL of tables of storage over database/sql
+func GenerateSQLSchema(proj *memdata.Project) string {
+	prepareProject(proj)
+	checkSQL(proj)
+	out := &strings.Builder{}
+	for _, statement := range sqlSchema(proj) {
+		out.WriteString(statement + ";\n\n")
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// generateSQL defines DDL, reads and writes of models over database/sql and storages based on them. Reads of storage
+// interfaces have no errors, so errors of database are panics (as well as errors of writes without errors mode).
+func generateSQL(proj *memdata.Project) jen.Code {
+	executor := sqlExecutorType(proj)
+	schema := memdata.ToLowerCamel(proj.Name) + "SQLSchema"
+	withTx := "with" + proj.Name + "SQLTx"
+	returnIfErr := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+
+	code := jen.Comment(executor + " is common part of *sql.DB and *sql.Tx").Line()
+	code.Type().Id(executor).Interface(
+		jen.Id("Exec").Params(jen.Id("query").String(), jen.Id("args").Op("...").Interface()).Params(jen.Qual("database/sql", "Result"), jen.Error()),
+		jen.Id("Query").Params(jen.Id("query").String(), jen.Id("args").Op("...").Interface()).Params(jen.Op("*").Qual("database/sql", "Rows"), jen.Error()),
+	).Line()
+	code.Var().Id(schema).Op("=").Index().String().ValuesFunc(func(values *jen.Group) {
+		for _, statement := range sqlSchema(proj) {
+			values.Line().Lit(statement)
+		}
+		values.Line()
+	}).Line()
+	code.Comment("Create" + proj.Name + "SQLSchema creates tables of models and join tables of many-links").Line()
+	code.Func().Id("Create"+proj.Name+"SQLSchema").Params(jen.Id("db").Op("*").Qual("database/sql", "DB")).Error().Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("statement")).Op(":=").Range().Id(schema)).Block(
+			jen.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("db").Dot("Exec").Call(jen.Id("statement")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err()),
+		),
+		jen.Return().Nil(),
+	).Line()
+	// writes in SQL transaction
+	begin := jen.Id("db").Dot("Begin").Call()
+	if proj.Errors {
+		// SQL transaction is rolled back if context is done
+		begin = jen.Id("db").Dot("BeginTx").Call(jen.Id("ctx"), jen.Nil())
+	}
+	code.Func().Id(withTx).Params(withContext(proj, jen.Id("db").Op("*").Qual("database/sql", "DB"), jen.Id("writes").Func().Params(jen.Id("exec").Id(executor)).Error())...).Error().Block(
+		jen.List(jen.Id("tx"), jen.Err()).Op(":=").Add(begin),
+		returnIfErr.Clone(),
+		jen.If(jen.Err().Op(":=").Id("writes").Call(jen.Id("tx")), jen.Err().Op("!=").Nil()).Block(
+			jen.Id("_").Op("=").Id("tx").Dot("Rollback").Call(),
+			jen.Return().Err(),
+		),
+		jen.Return().Id("tx").Dot("Commit").Call(),
+	).Line()
+	for _, model := range proj.Models {
+		code.Add(generateSQLModel(model))
+	}
+	if proj.Transactional {
+		code.Add(generateSQLTxStorage(proj))
+	} else {
+		for _, model := range proj.Models {
+			code.Add(generateSQLStorage(model))
+		}
+	}
+	return code
+}
+
+// generateSQLModel defines insert, update, delete and select of model items
+func generateSQLModel(model *memdata.Model) jen.Code {
+	proj := model.Project
+	executor := jen.Id("exec").Id(sqlExecutorType(proj))
+	quote := func(name string) string { return sqlQuote(proj, name) }
+	columns := sqlColumns(model)
+	keyType := jen.Id(model.FieldType(model.Indexed))
+	table := quote(sqlTable(model))
+	returnIfErr := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+	var many []modelLink
+	for _, link := range modelLinks(model) {
+		if link.Many {
+			many = append(many, link)
+		}
+	}
+	// arguments of columns (JSON of values is encoded before)
+	args := func(group *jen.Group, columns []sqlColumn) []jen.Code {
+		var values []jen.Code
+		for _, column := range columns {
+			if !column.JSON {
+				values = append(values, jen.Id("item").Dot(column.Field))
+				continue
+			}
+			group.List(jen.Id("json"+column.Field), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("item").Dot(column.Field))
+			group.Add(returnIfErr.Clone())
+			values = append(values, jen.String().Call(jen.Id("json"+column.Field)))
+		}
+		return values
+	}
+	// replace keys of many-links by keys of item
+	insertLinks := func(group *jen.Group) {
+		for _, link := range many {
+			query := "INSERT INTO " + quote(sqlLinkTable(model, link)) + " (" + quote("owner") + ", " + quote("position") + ", " + quote("target") + ") VALUES (" +
+				sqlPlaceholder(proj, 1) + ", " + sqlPlaceholder(proj, 2) + ", " + sqlPlaceholder(proj, 3) + ")"
+			group.For(jen.List(jen.Id("position"), jen.Id("target")).Op(":=").Range().Id("item").Dot(link.Field)).Block(
+				jen.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("exec").Dot("Exec").Call(jen.Lit(query), jen.Id("item").Dot(model.Indexed), jen.Id("position"), jen.Id("target")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err()),
+			)
+		}
+	}
+	deleteLinks := func(group *jen.Group, key jen.Code) {
+		for _, link := range many {
+			query := "DELETE FROM " + quote(sqlLinkTable(model, link)) + " WHERE " + quote("owner") + " = " + sqlPlaceholder(proj, 1)
+			group.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("exec").Dot("Exec").Call(jen.Lit(query), key), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		}
+	}
+
+	code := jen.Line()
+	code.Func().Id("insertSQL"+model.Name).Params(executor, jen.Id("item").Op("*").Id(model.Name)).Error().BlockFunc(func(insertFunc *jen.Group) {
+		var names, placeholders []string
+		for i, column := range columns {
+			names = append(names, quote(column.Name))
+			placeholders = append(placeholders, sqlPlaceholder(proj, i+1))
+		}
+		values := args(insertFunc, columns)
+		query := "INSERT INTO " + table + " (" + strings.Join(names, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
+		insertFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("exec").Dot("Exec").Call(append([]jen.Code{jen.Lit(query)}, values...)...), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		insertLinks(insertFunc)
+		insertFunc.Return().Nil()
+	}).Line()
+	code.Func().Id("updateSQL"+model.Name).Params(executor, jen.Id("item").Op("*").Id(model.Name)).Error().BlockFunc(func(updateFunc *jen.Group) {
+		if len(columns) > 1 {
+			var sets []string
+			for i, column := range columns[1:] {
+				sets = append(sets, quote(column.Name)+" = "+sqlPlaceholder(proj, i+1))
+			}
+			values := append(args(updateFunc, columns[1:]), jen.Id("item").Dot(model.Indexed))
+			query := "UPDATE " + table + " SET " + strings.Join(sets, ", ") + " WHERE " + quote(columns[0].Name) + " = " + sqlPlaceholder(proj, len(columns))
+			updateFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("exec").Dot("Exec").Call(append([]jen.Code{jen.Lit(query)}, values...)...), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		}
+		deleteLinks(updateFunc, jen.Id("item").Dot(model.Indexed))
+		insertLinks(updateFunc)
+		updateFunc.Return().Nil()
+	}).Line()
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	code.Func().Id("deleteSQL"+model.Name).Params(executor, jen.Id(keyName).Add(keyType)).Error().BlockFunc(func(deleteFunc *jen.Group) {
+		deleteLinks(deleteFunc, jen.Id(keyName))
+		query := "DELETE FROM " + table + " WHERE " + quote(columns[0].Name) + " = " + sqlPlaceholder(proj, 1)
+		deleteFunc.List(jen.Id("_"), jen.Err()).Op(":=").Id("exec").Dot("Exec").Call(jen.Lit(query), jen.Id(keyName))
+		deleteFunc.Return().Err()
+	}).Line()
+	// select all items or item by key (if not nil) in order of keys
+	code.Func().Id("selectSQL"+model.Name).Params(executor, jen.Id(keyName).Op("*").Add(keyType), jen.Id("project").Id(proj.Name+"Reader")).Params(jen.Index().Op("*").Id(model.Name), jen.Error()).BlockFunc(func(selectFunc *jen.Group) {
+		var names []string
+		for _, column := range columns {
+			names = append(names, quote(column.Name))
+		}
+		selectFunc.Var().Id("args").Index().Interface()
+		if len(many) > 0 {
+			selectFunc.List(jen.Id("where"), jen.Id("ownerWhere")).Op(":=").List(jen.Lit(""), jen.Lit(""))
+		} else {
+			selectFunc.Id("where").Op(":=").Lit("")
+		}
+		selectFunc.If(jen.Id(keyName).Op("!=").Nil()).BlockFunc(func(byKey *jen.Group) {
+			byKey.Id("args").Op("=").Append(jen.Id("args"), jen.Op("*").Id(keyName))
+			byKey.Id("where").Op("=").Lit(" WHERE " + quote(columns[0].Name) + " = " + sqlPlaceholder(proj, 1))
+			if len(many) > 0 {
+				byKey.Id("ownerWhere").Op("=").Lit(" WHERE " + quote("owner") + " = " + sqlPlaceholder(proj, 1))
+			}
+		})
+		query := "SELECT " + strings.Join(names, ", ") + " FROM " + table
+		selectFunc.List(jen.Id("rows"), jen.Err()).Op(":=").Id("exec").Dot("Query").Call(jen.Lit(query).Op("+").Id("where").Op("+").Lit(" ORDER BY "+quote(columns[0].Name)), jen.Id("args").Op("..."))
+		selectFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		selectFunc.Defer().Id("rows").Dot("Close").Call()
+		selectFunc.Var().Id("items").Index().Op("*").Id(model.Name)
+		selectFunc.For(jen.Id("rows").Dot("Next").Call()).BlockFunc(func(row *jen.Group) {
+			row.Id("item").Op(":=").Op("&").Id(model.Name).Values(jen.Id("_project").Op(":").Id("project"))
+			var dest []jen.Code
+			for _, column := range columns {
+				if column.JSON {
+					row.Var().Id("json" + column.Field).Index().Byte()
+					dest = append(dest, jen.Op("&").Id("json"+column.Field))
+				} else {
+					dest = append(dest, jen.Op("&").Id("item").Dot(column.Field))
+				}
+			}
+			row.If(jen.Err().Op(":=").Id("rows").Dot("Scan").Call(dest...), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+			for _, column := range columns {
+				if column.JSON {
+					row.If(jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("json"+column.Field), jen.Op("&").Id("item").Dot(column.Field)), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+				}
+			}
+			row.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item"))
+		})
+		selectFunc.If(jen.Err().Op(":=").Id("rows").Dot("Err").Call(), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		if len(many) == 0 {
+			selectFunc.Return(jen.Id("items"), jen.Nil())
+			return
+		}
+		// keys of many-links are read after rows of items are closed
+		selectFunc.Id("_").Op("=").Id("rows").Dot("Close").Call()
+		selectFunc.Id("byKey").Op(":=").Make(jen.Map(keyType.Clone()).Op("*").Id(model.Name), jen.Len(jen.Id("items")))
+		selectFunc.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+			jen.Id("byKey").Index(jen.Id("item").Dot(model.Indexed)).Op("=").Id("item"),
+		)
+		for _, link := range many {
+			query := "SELECT " + quote("owner") + ", " + quote("target") + " FROM " + quote(sqlLinkTable(model, link))
+			selectFunc.If(jen.Err().Op(":=").Id("selectSQL"+model.Name+link.Name).Call(jen.Id("exec"), jen.Lit(query).Op("+").Id("ownerWhere").Op("+").Lit(" ORDER BY "+quote("owner")+", "+quote("position")), jen.Id("args"), jen.Id("byKey")), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			)
+		}
+		selectFunc.Return(jen.Id("items"), jen.Nil())
+	}).Line()
+	// keys of many-links in order of positions
+	for _, link := range many {
+		code.Func().Id("selectSQL"+model.Name+link.Name).Params(executor, jen.Id("query").String(), jen.Id("args").Index().Interface(), jen.Id("items").Map(keyType.Clone()).Op("*").Id(model.Name)).Error().BlockFunc(func(linkFunc *jen.Group) {
+			linkFunc.List(jen.Id("rows"), jen.Err()).Op(":=").Id("exec").Dot("Query").Call(jen.Id("query"), jen.Id("args").Op("..."))
+			linkFunc.Add(returnIfErr.Clone())
+			linkFunc.Defer().Id("rows").Dot("Close").Call()
+			linkFunc.For(jen.Id("rows").Dot("Next").Call()).Block(
+				jen.Var().Id("owner").Add(keyType.Clone()),
+				jen.Var().Id("target").Id(link.Target.FieldType(link.Target.Indexed)),
+				jen.If(jen.Err().Op(":=").Id("rows").Dot("Scan").Call(jen.Op("&").Id("owner"), jen.Op("&").Id("target")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err()),
+				jen.If(jen.Id("item").Op(":=").Id("items").Index(jen.Id("owner")), jen.Id("item").Op("!=").Nil()).Block(
+					jen.Id("item").Dot(link.Field).Op("=").Append(jen.Id("item").Dot(link.Field), jen.Id("target")),
+				),
+			)
+			linkFunc.Return().Id("rows").Dot("Err").Call()
+		}).Line()
+	}
+	return code
+}
+
+// generateSQLRead defines Get and Iterate of model on SQL storage
+func generateSQLRead(code *jen.Statement, objName string, model *memdata.Model) {
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	keyType := jen.Id(model.FieldType(model.Indexed))
+	recv := func() *jen.Statement { return jen.Params(jen.Id("storage").Op("*").Id(objName)) }
+	code.Func().Add(recv()).Id("Get"+model.Name).Params(jen.Id(keyName).Add(keyType)).Op("*").Id(model.Name).Block(
+		jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("selectSQL"+model.Name).Call(jen.Id("storage").Dot("db"), jen.Op("&").Id(keyName), jen.Id("storage").Dot("project")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err())),
+		jen.If(jen.Len(jen.Id("items")).Op("==").Lit(0)).Block(jen.Return().Nil()),
+		jen.Return().Id("items").Index(jen.Lit(0)),
+	).Line()
+	code.Func().Add(recv()).Id("Iterate"+model.Name).Params(jen.Id("iterator").Add(iteratorType(model))).Block(
+		jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("selectSQL"+model.Name).Call(jen.Id("storage").Dot("db"), jen.Nil(), jen.Id("storage").Dot("project")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err())),
+		jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+			jen.If(jen.Op("!").Id("iterator").Call(jen.Id("item").Dot(model.Indexed), jen.Id("item"))).Block(jen.Return()),
+		),
+	).Line()
+	generateStorageIter(code, objName, model)
+}
+
+// generateSQLStorage defines storage of model over database/sql (non-transactional mode): each write is SQL
+// transaction
+func generateSQLStorage(model *memdata.Model) jen.Code {
+	proj := model.Project
+	objName := "sql" + model.Name + "Storage"
+	keyName := memdata.ToLowerCamel(model.Indexed)
+	keyType := jen.Id(model.FieldType(model.Indexed))
+	executor := jen.Id("exec").Id(sqlExecutorType(proj))
+	withTx := "with" + proj.Name + "SQLTx"
+	recv := func() *jen.Statement { return jen.Params(jen.Id("storage").Op("*").Id(objName)) }
+	code := jen.Line()
+	code.Type().Id(objName).Struct(
+		jen.Id("db").Op("*").Qual("database/sql", "DB"),
+		jen.Id("project").Id(proj.Name+"Reader"),
+	).Line()
+	code.Comment("NewSQL" + model.Name + "Storage creates storage of " + model.Name + " over database/sql. " + sqlErrors(proj)).Line()
+	code.Func().Id("NewSQL" + model.Name + "Storage").Params(jen.Id("db").Op("*").Qual("database/sql", "DB")).Id(model.Name + "Storage").Block(
+		jen.Return().Op("&").Id(objName).Values(jen.Id("db").Op(":").Id("db")),
+	).Line()
+	generateStorageLinkMethod(code, objName, proj)
+	for _, action := range []string{"Put", "Update"} {
+		write := "insertSQL" + model.Name
+		if action == "Update" {
+			write = "updateSQL" + model.Name
+		}
+		code.Func().Add(recv()).Id(action + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType.Clone()), jen.Id("item").Op("*").Id(model.Name))...).Add(storageError(proj)).BlockFunc(func(writeFunc *jen.Group) {
+			generateSQLWrite(writeFunc, proj, jen.Id(withTx).Call(contextArg(proj, jen.Id("storage").Dot("db"), jen.Func().Params(executor.Clone()).Error().Block(
+				jen.Return().Id(write).Call(jen.Id("exec"), jen.Id("item")),
+			))...))
+		}).Line()
+	}
+	code.Func().Add(recv()).Id("Delete" + model.Name).Params(withContext(proj, jen.Id(keyName).Add(keyType.Clone()))...).Add(storageError(proj)).BlockFunc(func(deleteFunc *jen.Group) {
+		generateSQLWrite(deleteFunc, proj, jen.Id(withTx).Call(contextArg(proj, jen.Id("storage").Dot("db"), jen.Func().Params(executor.Clone()).Error().Block(
+			jen.Return().Id("deleteSQL"+model.Name).Call(jen.Id("exec"), jen.Id(keyName)),
+		))...))
+	}).Line()
+	generateSQLRead(code, objName, model)
+	return code
+}
+
+// generateSQLTxStorage defines transactional storage over database/sql: batch is applied in single SQL transaction
+func generateSQLTxStorage(proj *memdata.Project) jen.Code {
+	objName := "sql" + proj.Name + "Storage"
+	code := jen.Line()
+	code.Type().Id(objName).Struct(
+		jen.Id("db").Op("*").Qual("database/sql", "DB"),
+		jen.Id("project").Id(proj.Name+"Reader"),
+	).Line()
+	code.Comment("NewSQL" + proj.Name + "Storage creates transactional storage over database/sql. " + sqlErrors(proj)).Line()
+	code.Func().Id("NewSQL" + proj.Name + "Storage").Params(jen.Id("db").Op("*").Qual("database/sql", "DB")).Id(proj.Name + "TxStorage").Block(
+		jen.Return().Op("&").Id(objName).Values(jen.Id("db").Op(":").Id("db")),
+	).Line()
+	generateStorageLinkMethod(code, objName, proj)
+	for _, model := range proj.Models {
+		generateSQLRead(code, objName, model)
+	}
+	code.Func().Params(jen.Id("storage").Op("*").Id(objName)).Id("Apply").Params(withContext(proj, jen.Id("batch").Index().Id(proj.Name+"LogEntity"))...).Add(storageError(proj)).BlockFunc(func(applyFunc *jen.Group) {
+		generateSQLWrite(applyFunc, proj, jen.Id("with"+proj.Name+"SQLTx").Call(contextArg(proj, jen.Id("storage").Dot("db"), jen.Func().Params(jen.Id("exec").Id(sqlExecutorType(proj))).Error().BlockFunc(func(applyFunc *jen.Group) {
+			applyFunc.For(jen.List(jen.Id("_"), jen.Id("tx")).Op(":=").Range().Id("batch")).BlockFunc(func(batchItem *jen.Group) {
+				for _, model := range proj.Models {
+					entity := jen.Id("tx").Dot(model.Name)
+					batchItem.If(entity.Clone().Op("!=").Nil()).Block(
+						jen.Var().Err().Error(),
+						jen.Switch(entity.Clone().Dot("Action")).Block(
+							jen.Case(jen.Id(proj.Name+"ActionInsert")).Block(
+								jen.Err().Op("=").Id("insertSQL"+model.Name).Call(jen.Id("exec"), jen.Op("&").Add(entity.Clone()).Dot("Item")),
+							),
+							jen.Case(jen.Id(proj.Name+"ActionUpdate")).Block(
+								jen.Err().Op("=").Id("updateSQL"+model.Name).Call(jen.Id("exec"), jen.Op("&").Add(entity.Clone()).Dot("Item")),
+							),
+							jen.Case(jen.Id(proj.Name+"ActionDelete")).Block(
+								jen.Err().Op("=").Id("deleteSQL"+model.Name).Call(jen.Id("exec"), entity.Clone().Dot(model.Indexed)),
+							),
+						),
+						jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err()),
+					)
+				}
+			})
+			applyFunc.Return().Nil()
+		}))...))
+	}).Line()
+	return code
+}
+
+// sqlErrors describes errors of SQL storage
+func sqlErrors(proj *memdata.Project) string {
+	if proj.Errors {
+		return "Errors of reads are panics"
+	}
+	return "Errors of database are panics"
+}
+
+// generateSQLWrite returns error of SQL transaction in errors mode or panics with it
+func generateSQLWrite(group *jen.Group, proj *memdata.Project, write jen.Code) {
+	if proj.Errors {
+		group.Return().Add(write)
+		return
+	}
+	group.Err().Op(":=").Add(write)
+	group.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err()))
+}
@@ -0,0 +1,110 @@
+package binary
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func sample() *Item {
+	parent := int64(-5)
+	deleted := time.Date(2021, 2, 3, 4, 5, 6, 7, time.UTC)
+	return &Item{
+		Id:         "item",
+		Count:      -42,
+		Small:      -3,
+		Flags:      0xffff,
+		Ratio:      0.25,
+		Price:      -1.5,
+		Active:     true,
+		Tags:       []string{"a", "", "ЯЯ"},
+		Raw:        []byte{0, 1, 2},
+		Attributes: map[string][]int64{"x": {1, 2}, "y": nil},
+		Events:     map[int]time.Time{2: deleted, 1: deleted},
+		Parent:     &parent,
+		Created:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Deleted:    &deleted,
+		History:    []time.Time{deleted},
+		OwnerId:    7,
+		WatchersId: []int64{1, 2, 3},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	item := sample()
+	data, err := item.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Item
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(item, &restored) {
+		t.Fatalf("restored %+v", restored)
+	}
+	// zero item
+	data, _ = (&Item{}).MarshalBinary()
+	var empty Item
+	if err := empty.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if empty.Parent != nil || empty.Tags != nil || empty.Attributes != nil {
+		t.Fatalf("restored %+v", empty)
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	prefix := []byte("prefix")
+	data, err := sample().AppendBinary(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	marshaled, _ := sample().MarshalBinary()
+	if !bytes.Equal(data[:len(prefix)], prefix) || !bytes.Equal(data[len(prefix):], marshaled) {
+		t.Fatal("unexpected appended data")
+	}
+}
+
+func TestInvalidData(t *testing.T) {
+	data, _ := sample().MarshalBinary()
+	for i := 0; i < len(data); i++ {
+		var item Item
+		if err := item.UnmarshalBinary(data[:i]); err == nil {
+			t.Fatalf("truncated data (%d of %d bytes) is decoded", i, len(data))
+		}
+	}
+	var item Item
+	if err := item.UnmarshalBinary(append(data, 0)); err == nil {
+		t.Fatal("trailing data is decoded")
+	}
+}
+
+func TestLogEntity(t *testing.T) {
+	entity := &ItemLogEntity{Id: "item", Item: *sample(), Action: DataActionUpdate}
+	data, err := entity.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored ItemLogEntity
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entity, &restored) {
+		t.Fatalf("restored %+v", restored)
+	}
+	// gob uses binary marshaling too
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(DataLogEntity{Item: entity}); err != nil {
+		t.Fatal(err)
+	}
+	var batch DataLogEntity
+	if err := gob.NewDecoder(&buffer).Decode(&batch); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entity, batch.Item) {
+		t.Fatalf("restored %+v", batch.Item)
+	}
+}
@@ -0,0 +1,84 @@
+package btree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedStorage(t *testing.T) {
+	storage := NewBTreeDataStorage(3)
+	project := NewData(storage)
+	tx := project.ReadWriteLock()
+	user, _ := tx.InsertUser(&User{Name: "user"})
+	for _, name := range []string{"delta", "alpha", "charlie", "bravo", "echo", "foxtrot", "golf"} {
+		if _, err := tx.InsertTag(&Tag{Name: name, UserId: user.Id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	tx.RemoveTag("charlie")
+	tx.RemoveTag("foxtrot")
+	tx.UpdateTag(&Tag{Name: "echo"})
+	tx.Commit()
+
+	var names []string
+	storage.IterateTag(func(name string, item *Tag) bool {
+		names = append(names, name)
+		return true
+	})
+	if !reflect.DeepEqual(names, []string{"alpha", "bravo", "delta", "echo", "golf"}) {
+		t.Fatalf("unexpected order: %v", names)
+	}
+	// state is restored from storage
+	project = NewData(storage)
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if tag := view.Tag("echo"); tag == nil || tag.UserId != 0 {
+		t.Fatalf("unexpected tag: %v", tag)
+	}
+	if items := view.TagByUser(user.Id); len(items) != 4 {
+		t.Fatalf("index is not restored: %v", items)
+	}
+	// range queries use order of B-tree
+	names = nil
+	view.TagRange("b", "f", func(item *Tag) bool {
+		names = append(names, item.Name)
+		return true
+	})
+	if !reflect.DeepEqual(names, []string{"bravo", "delta", "echo"}) {
+		t.Fatalf("unexpected range: %v", names)
+	}
+	if view.TagFirst().Name != "alpha" || view.TagLast().Name != "golf" {
+		t.Fatal("unexpected first or last")
+	}
+	if view.TagFloor("c").Name != "bravo" || view.TagCeiling("c").Name != "delta" || view.TagFloor("a") != nil || view.TagCeiling("h") != nil {
+		t.Fatal("unexpected floor or ceiling")
+	}
+	if _, ok := storage.(TagOrderedStorage); !ok {
+		t.Fatal("B-tree storage is not ordered")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	for i := 0; i < 100; i++ {
+		tx.InsertUser(&User{Name: "user"})
+	}
+	tx.Commit()
+	tx = project.ReadWriteLock()
+	for i := int64(1); i <= 100; i += 2 {
+		tx.RemoveUser(i)
+	}
+	tx.Commit()
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if items := view.UserByName("user"); len(items) != 50 {
+		t.Fatalf("unexpected number of users: %v", len(items))
+	}
+	if view.User(1) != nil || view.User(2) == nil {
+		t.Fatal("unexpected state")
+	}
+}
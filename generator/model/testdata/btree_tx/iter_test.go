@@ -0,0 +1,62 @@
+package btree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIteration(t *testing.T) {
+	storage := NewBTreeDataStorage(3)
+	project := NewData(storage)
+	tx := project.ReadWriteLock()
+	for _, name := range []string{"delta", "alpha", "charlie", "bravo", "echo", "foxtrot", "golf"} {
+		if _, err := tx.InsertTag(&Tag{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	tx.RemoveTag("bravo")
+	tx.InsertTag(&Tag{Name: "beta"})
+	// sequence of the project includes pending changes
+	var names []string
+	for name := range tx.IterTag() {
+		names = append(names, name)
+		if name == "charlie" {
+			break
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"alpha", "beta", "charlie"}) {
+		t.Fatalf("unexpected sequence: %v", names)
+	}
+	// sequences of the storage and the tree have committed items only
+	names = nil
+	for name := range storage.(*memDataBTreeStorage).IterTag() {
+		names = append(names, name)
+		if len(names) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"alpha", "bravo"}) {
+		t.Fatalf("unexpected storage sequence: %v", names)
+	}
+	names = nil
+	for name := range storage.(*memDataBTreeStorage).Tag.Backward() {
+		names = append(names, name)
+		if len(names) == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"golf", "foxtrot", "echo"}) {
+		t.Fatalf("unexpected backward sequence: %v", names)
+	}
+	var count int
+	for range storage.(*memDataBTreeStorage).Tag.All() {
+		count++
+	}
+	if count != 7 {
+		t.Fatalf("unexpected count %d", count)
+	}
+}
@@ -0,0 +1,44 @@
+package defaults
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaults(t *testing.T) {
+	project := DefaultData()
+	post, _ := project.InsertPost(&Post{Title: "hello"})
+	if post.Status != "draft" || post.Rating != 2.5 || post.Views != 1 || !*post.Public || *post.Score != 5 {
+		t.Fatalf("defaults are not set: %+v", post)
+	}
+	post, _ = project.InsertPost(&Post{Status: "published", Views: 10})
+	if post.Status != "published" || post.Views != 10 {
+		t.Fatalf("values are replaced by defaults: %+v", post)
+	}
+	// zero values of pointer fields are kept
+	public, score := false, 0
+	post, _ = project.InsertPost(&Post{Public: &public, Score: &score})
+	if *post.Public || *post.Score != 0 {
+		t.Fatalf("zero values are replaced by defaults: %+v", post)
+	}
+}
+
+func TestTimestamps(t *testing.T) {
+	project := DefaultData()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	project.SetClock(func() time.Time { return now })
+	post, _ := project.InsertPost(&Post{Title: "hello"})
+	if !post.CreatedAt.Equal(now) || !post.UpdatedAt.Equal(now) {
+		t.Fatalf("unexpected timestamps: %+v", post)
+	}
+	created := now
+	now = now.Add(time.Hour)
+	changed := *post
+	changed.CreatedAt = time.Time{}
+	if _, err := project.UpdatePost(&changed); err != nil {
+		t.Fatal(err)
+	}
+	if stored := project.Post(post.Id); !stored.CreatedAt.Equal(created) || !stored.UpdatedAt.Equal(now) {
+		t.Fatalf("unexpected timestamps after update: %+v", stored)
+	}
+}
@@ -0,0 +1,27 @@
+package defaults
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimestamps(t *testing.T) {
+	project := DefaultData()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	project.SetClock(func() time.Time { return now })
+	tx := project.ReadWriteLock()
+	post, _ := tx.InsertPost(&Post{Title: "hello"})
+	created := now
+	now = now.Add(time.Hour)
+	changed := *post
+	changed.CreatedAt = time.Time{}
+	_, _ = tx.UpdatePost(&changed)
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	stored := view.Post(post.Id)
+	if !stored.CreatedAt.Equal(created) || !stored.UpdatedAt.Equal(now) || stored.Status != "draft" {
+		t.Fatalf("unexpected item: %+v", stored)
+	}
+}
@@ -0,0 +1,160 @@
+package failing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var errFailed = errors.New("failed")
+
+// failingKV fails writes of keys with prefix and writes with done context
+type failingKV struct {
+	DataKV
+	prefix string
+}
+
+func (kv *failingKV) check(ctx context.Context, key []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if kv.prefix != "" && bytes.HasPrefix(key, []byte(kv.prefix)) {
+		return errFailed
+	}
+	return nil
+}
+
+func (kv *failingKV) Put(ctx context.Context, key, value []byte) error {
+	if err := kv.check(ctx, key); err != nil {
+		return err
+	}
+	return kv.DataKV.Put(ctx, key, value)
+}
+
+func (kv *failingKV) Delete(ctx context.Context, key []byte) error {
+	if err := kv.check(ctx, key); err != nil {
+		return err
+	}
+	return kv.DataKV.Delete(ctx, key)
+}
+
+func newProject() (Data, *failingKV) {
+	kv := &failingKV{DataKV: NewMemoryDataKV()}
+	storage := NewKVDataStorage(kv, nil)
+	return NewData(storage, storage), kv
+}
+
+func TestFailedWrite(t *testing.T) {
+	ctx := context.Background()
+	project, kv := newProject()
+	var events int
+	project.SubscribeUser(func(old, new *User, action DataAction) {
+		events++
+	})
+	alice, err := project.InsertUser(ctx, &User{Name: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kv.prefix = "User/"
+	if _, err := project.InsertUser(ctx, &User{Name: "bob"}); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	if users := project.UserByName("bob"); len(users) != 0 {
+		t.Fatalf("index updated by failed insert: %v", users)
+	}
+	if _, err := project.UpdateUser(ctx, &User{Id: alice.Id, Name: "carol", Version: alice.Version}); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	if users := project.UserByName("alice"); len(users) != 1 || users[0].Name != "alice" {
+		t.Fatalf("failed update changed state: %v", users)
+	}
+	if err := project.RemoveUser(ctx, alice.Id); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	if project.User(alice.Id) == nil {
+		t.Fatal("user removed by failed remove")
+	}
+	if events != 1 {
+		t.Fatalf("failed writes published: %d events", events)
+	}
+
+	kv.prefix = ""
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := project.InsertUser(canceled, &User{Name: "dave"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("context is not passed to storage: %v", err)
+	}
+	if _, err := project.InsertUser(ctx, &User{Name: "dave"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRetryFailedWrite(t *testing.T) {
+	ctx := context.Background()
+	project, kv := newProject()
+	alice, _ := project.InsertUser(ctx, &User{Name: "alice"})
+	kv.prefix = "User/"
+	bob := &User{Name: "bob"}
+	if _, err := project.InsertUser(ctx, bob); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	changed := *alice
+	changed.Name = "carol"
+	if _, err := project.UpdateUser(ctx, &changed); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	if bob.Id != 0 || bob.Version != 0 || changed.Version != 1 {
+		t.Fatalf("items are changed by failed writes: %+v, %+v", bob, changed)
+	}
+	// retry of the same items after failure
+	kv.prefix = ""
+	if _, err := project.InsertUser(ctx, bob); err != nil {
+		t.Fatal(err)
+	}
+	if bob.Id != alice.Id+1 {
+		t.Fatalf("failed insert consumed sequence: %+v", bob)
+	}
+	if _, err := project.UpdateUser(ctx, &changed); err != nil {
+		t.Fatal(err)
+	}
+	if stored := project.User(alice.Id); stored.Name != "carol" || stored.Version != 2 {
+		t.Fatalf("unexpected user after retry: %+v", stored)
+	}
+}
+
+func TestFailedCascade(t *testing.T) {
+	ctx := context.Background()
+	project, kv := newProject()
+	alice, _ := project.InsertUser(ctx, &User{Name: "alice"})
+	if _, err := project.InsertTag(ctx, &Tag{Name: "admin", OwnerId: alice.Id}); err != nil {
+		t.Fatal(err)
+	}
+	kv.prefix = "Tag/"
+	if err := project.RemoveUser(ctx, alice.Id); !errors.Is(err, errFailed) {
+		t.Fatalf("error of cascade is not returned: %v", err)
+	}
+	// non-transactional writes done before the failure are kept
+	if project.User(alice.Id) != nil || project.Tag("admin") == nil {
+		t.Fatal("unexpected state after failed cascade")
+	}
+	kv.prefix = ""
+	if err := project.RemoveTag(ctx, "admin"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	project, kv := newProject()
+	handler := NewDataHandler(project)
+	kv.prefix = "User/"
+	request := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"Name":"alice"}`))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	if response.Code != http.StatusInternalServerError || !strings.Contains(response.Body.String(), errFailed.Error()) {
+		t.Fatalf("unexpected response %d: %s", response.Code, response.Body.String())
+	}
+}
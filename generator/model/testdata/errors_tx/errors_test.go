@@ -0,0 +1,147 @@
+package failing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var errFailed = errors.New("failed")
+
+// failingStorage fails batches while fail is set and closes file of log (if set) on failure
+type failingStorage struct {
+	DataTxStorage
+	fail bool
+	wal  *DataWAL
+}
+
+func (storage *failingStorage) Apply(ctx context.Context, batch []DataLogEntity) error {
+	if storage.fail {
+		if storage.wal != nil {
+			_ = storage.wal.file.Close()
+		}
+		return errFailed
+	}
+	return storage.DataTxStorage.Apply(ctx, batch)
+}
+
+func open(t *testing.T, path string) (Data, *failingStorage, *DataWAL) {
+	wal, err := OpenDataWAL(path, DataSyncEachCommit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage := &failingStorage{DataTxStorage: NewMapDataStorage()}
+	project, err := NewData(storage, wal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return project, storage, wal
+}
+
+func TestFailedCommit(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "data.wal")
+	project, storage, wal := open(t, path)
+
+	storage.fail = true
+	tx := project.ReadWriteLock()
+	alice, err := tx.InsertUser(ctx, &User{Name: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(ctx); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	// transaction is kept: own changes are visible, readers don't see them
+	if users := tx.UserByName("alice"); len(users) != 1 {
+		t.Fatalf("transaction is not kept: %v", users)
+	}
+	view := project.ReadLock()
+	if view.User(alice.Id) != nil {
+		t.Fatal("failed commit is visible")
+	}
+	view.ReadUnlock()
+	storage.fail = false
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	storage.fail = true
+	tx = project.ReadWriteLock()
+	if _, err := tx.InsertUser(ctx, &User{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(ctx); !errors.Is(err, errFailed) {
+		t.Fatalf("error of storage is not returned: %v", err)
+	}
+	tx.Discard()
+	storage.fail = false
+	view = project.ReadLock()
+	if users := view.UserByName("bob"); len(users) != 0 {
+		t.Fatalf("discarded transaction is visible: %v", users)
+	}
+	view.ReadUnlock()
+	if err := wal.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// failed batches are not replayed
+	restored, _, wal := open(t, path)
+	defer wal.Close()
+	view = restored.ReadLock()
+	defer view.ReadUnlock()
+	if users := view.UserByName("alice"); len(users) != 1 {
+		t.Fatalf("retried commit is not replayed: %v", users)
+	}
+	if users := view.UserByName("bob"); len(users) != 0 {
+		t.Fatalf("failed commit is replayed: %v", users)
+	}
+}
+
+func TestBrokenWAL(t *testing.T) {
+	ctx := context.Background()
+	project, storage, wal := open(t, filepath.Join(t.TempDir(), "data.wal"))
+	storage.fail = true
+	storage.wal = wal
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	if _, err := tx.InsertUser(ctx, &User{Name: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	// failed batch could not be cut off from the closed log
+	err := tx.Commit(ctx)
+	if !errors.Is(err, errFailed) || !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("errors of storage and log are not returned: %v", err)
+	}
+	storage.fail = false
+	if err := tx.Commit(ctx); err == nil || !strings.Contains(err.Error(), "WAL is broken") {
+		t.Fatalf("broken log is used: %v", err)
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	storage := &failingStorage{DataTxStorage: NewMapDataStorage(), fail: true}
+	project, err := NewData(storage, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := NewDataHandler(project)
+	request := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"Name":"alice"}`))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	if response.Code != http.StatusInternalServerError {
+		t.Fatalf("unexpected response %d: %s", response.Code, response.Body.String())
+	}
+	// failed transaction is discarded by handler
+	storage.fail = false
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	if users := tx.UserByName("alice"); len(users) != 0 {
+		t.Fatalf("failed transaction is kept: %v", users)
+	}
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"testing"
+)
+
+type change struct {
+	old, new *User
+	action   DataAction
+}
+
+func TestSubscribe(t *testing.T) {
+	project := DefaultData()
+	var changes []change
+	var removedTransfers int
+	unsubscribe := project.SubscribeUser(func(old, new *User, action DataAction) {
+		// handlers could use the project
+		if new != nil && project.User(new.Id) == nil {
+			t.Error("change is not visible")
+		}
+		changes = append(changes, change{old, new, action})
+	})
+	project.SubscribeTransfer(func(old, new *Transfer, action DataAction) {
+		if action == DataActionDelete {
+			removedTransfers++
+		}
+	})
+	user, _ := project.InsertUser(&User{Name: "alice"})
+	if _, err := project.InsertTransfer(&Transfer{FromId: user.Id}); err != nil {
+		t.Fatal(err)
+	}
+	changed := *user
+	changed.Name = "bob"
+	if _, err := project.UpdateUser(&changed); err != nil {
+		t.Fatal(err)
+	}
+	if err := project.RemoveUser(user.Id); err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %d", len(changes))
+	}
+	if changes[0].old != nil || changes[0].new.Name != "alice" || changes[0].action != DataActionInsert {
+		t.Fatalf("unexpected insert %+v", changes[0])
+	}
+	if changes[1].old.Name != "alice" || changes[1].new.Name != "bob" || changes[1].action != DataActionUpdate {
+		t.Fatalf("unexpected update %+v", changes[1])
+	}
+	if changes[2].old.Name != "bob" || changes[2].new != nil || changes[2].action != DataActionDelete {
+		t.Fatalf("unexpected remove %+v", changes[2])
+	}
+	if removedTransfers != 1 {
+		t.Fatalf("cascade removal is not published")
+	}
+	unsubscribe()
+	_, _ = project.InsertUser(&User{Name: "carol"})
+	if len(changes) != 3 {
+		t.Fatal("handler called after unsubscribe")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	project := DefaultData()
+	watcher := project.Watch()
+	for _, name := range []string{"a", "b", "c"} {
+		_, _ = project.InsertUser(&User{Name: name})
+	}
+	// buffer keeps the last changes
+	if entity := <-watcher; entity.User == nil || entity.User.Item.Name != "b" {
+		t.Fatalf("unexpected change %+v", entity)
+	}
+	if entity := <-watcher; entity.User.Item.Name != "c" {
+		t.Fatalf("unexpected change %+v", entity)
+	}
+	project.Unwatch(watcher)
+	if _, ok := <-watcher; ok {
+		t.Fatal("channel is not closed")
+	}
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	project := DefaultData()
+	var names []string
+	var olds []*User
+	project.SubscribeUser(func(old, new *User, action DataAction) {
+		// handlers could use the project
+		view := project.ReadLock()
+		view.ReadUnlock()
+		olds = append(olds, old)
+		if new != nil {
+			names = append(names, new.Name)
+		} else {
+			names = append(names, "-")
+		}
+	})
+	watcher := project.Watch()
+	defer project.Unwatch(watcher)
+
+	tx := project.ReadWriteLock()
+	user, _ := tx.InsertUser(&User{Name: "alice"})
+	changed := *user
+	changed.Name = "bob"
+	_, _ = tx.UpdateUser(&changed)
+	if len(names) != 0 {
+		t.Fatal("pending changes are published")
+	}
+	tx.Commit()
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Fatalf("unexpected changes %v", names)
+	}
+	if olds[0] != nil || olds[1] == nil || olds[1].Name != "alice" {
+		t.Fatalf("unexpected previous state %v", olds)
+	}
+	// discarded changes are not published
+	tx = project.ReadWriteLock()
+	_ = tx.RemoveUser(user.Id)
+	tx.Discard()
+	if len(names) != 2 {
+		t.Fatalf("discarded changes are published: %v", names)
+	}
+	if entity := <-watcher; entity.User.Action != DataActionInsert {
+		t.Fatalf("unexpected change %+v", entity)
+	}
+}
+
+func TestPublishOrder(t *testing.T) {
+	project := DefaultData()
+	var last int64
+	var unordered int
+	project.SubscribeUser(func(old, new *User, action DataAction) {
+		// handlers are not called concurrently
+		if new.Id != last+1 {
+			unordered++
+		}
+		last = new.Id
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tx := project.ReadWriteLock()
+				_, _ = tx.InsertUser(&User{Name: "user"})
+				tx.Commit()
+			}
+		}()
+	}
+	wg.Wait()
+	if unordered != 0 || last != 800 {
+		t.Fatalf("events published out of order of commits: %d of %d", unordered, last)
+	}
+}
+
+func TestCommitFromHandler(t *testing.T) {
+	project := DefaultData()
+	var changes []string
+	project.SubscribeUser(func(old, new *User, action DataAction) {
+		changes = append(changes, "user")
+		tx := project.ReadWriteLock()
+		_, _ = tx.InsertTransfer(&Transfer{FromId: new.Id})
+		tx.Commit()
+	})
+	project.SubscribeTransfer(func(old, new *Transfer, action DataAction) {
+		changes = append(changes, "transfer")
+	})
+	tx := project.ReadWriteLock()
+	_, _ = tx.InsertUser(&User{Name: "alice"})
+	tx.Commit()
+	if len(changes) != 2 || changes[0] != "user" || changes[1] != "transfer" {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+}
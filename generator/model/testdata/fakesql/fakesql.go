@@ -0,0 +1,582 @@
+// Package fakesql is in-process database/sql driver for tests of generated SQL storages. It understands only
+// statements of generated code: CREATE TABLE, INSERT, UPDATE and DELETE by key, SELECT (optionally by key) with
+// ORDER BY. Databases are shared by name of data source, transactions are rolled back by restoring copy of tables.
+package fakesql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+func init() {
+	sql.Register("fakesql", fakeDriver{})
+}
+
+var (
+	lock      sync.Mutex
+	databases = make(map[string]*database)
+)
+
+type table struct {
+	columns []string
+	key     []string
+	rows    []map[string]driver.Value
+}
+
+type database struct {
+	tables map[string]*table
+}
+
+func (db *database) copyTables() map[string]*table {
+	tables := make(map[string]*table, len(db.tables))
+	for name, src := range db.tables {
+		dst := &table{columns: src.columns, key: src.key}
+		for _, row := range src.rows {
+			cp := make(map[string]driver.Value, len(row))
+			for column, value := range row {
+				cp[column] = value
+			}
+			dst.rows = append(dst.rows, cp)
+		}
+		tables[name] = dst
+	}
+	return tables
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	db, ok := databases[name]
+	if !ok {
+		db = &database{tables: make(map[string]*table)}
+		databases[name] = db
+	}
+	return &conn{db: db}, nil
+}
+
+type conn struct {
+	db *database
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{db: c.db, query: query}, nil
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	return &tx{db: c.db, saved: c.db.copyTables()}, nil
+}
+
+type tx struct {
+	db    *database
+	saved map[string]*table
+}
+
+func (t *tx) Commit() error { return nil }
+
+func (t *tx) Rollback() error {
+	lock.Lock()
+	defer lock.Unlock()
+	t.db.tables = t.saved
+	return nil
+}
+
+type stmt struct {
+	db    *database
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	p, err := newParser(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	var affected int64
+	switch {
+	case p.accept("CREATE"):
+		err = p.create(s.db)
+	case p.accept("INSERT"):
+		err = p.insert(s.db)
+		affected = 1
+	case p.accept("UPDATE"):
+		affected, err = p.update(s.db)
+	case p.accept("DELETE"):
+		affected, err = p.delete(s.db)
+	default:
+		err = fmt.Errorf("unsupported statement %q", s.query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(affected), nil
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	p, err := newParser(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("SELECT") {
+		return nil, fmt.Errorf("unsupported query %q", s.query)
+	}
+	return p.selectRows(s.db)
+}
+
+type token struct {
+	text   string
+	quoted bool
+	param  int // index of argument from 1 for placeholders
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	args   []driver.Value
+}
+
+func newParser(query string, args []driver.Value) (*parser, error) {
+	p := &parser{args: args}
+	next := 0
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\n' || c == '\t':
+			i++
+		case c == '"' || c == '`':
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated identifier")
+			}
+			p.tokens = append(p.tokens, token{text: query[i+1 : i+1+end], quoted: true})
+			i += end + 2
+		case c == '?':
+			next++
+			p.tokens = append(p.tokens, token{text: "?", param: next})
+			i++
+		case c == '$':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(query[i+1 : j])
+			if err != nil {
+				return nil, err
+			}
+			p.tokens = append(p.tokens, token{text: query[i:j], param: n})
+			i = j
+		case strings.IndexByte("(),=;", c) >= 0:
+			p.tokens = append(p.tokens, token{text: string(c)})
+			i++
+		default:
+			j := i
+			for j < len(query) && strings.IndexByte(" \n\t\"`?$(),=;", query[j]) < 0 {
+				j++
+			}
+			p.tokens = append(p.tokens, token{text: strings.ToUpper(query[i:j])})
+			i = j
+		}
+	}
+	return p, nil
+}
+
+func (p *parser) peek() token {
+	if p.pos >= len(p.tokens) {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) accept(text string) bool {
+	if t := p.peek(); !t.quoted && t.param == 0 && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		return fmt.Errorf("expected %s, got %q", text, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) name() (string, error) {
+	t := p.peek()
+	if !t.quoted {
+		return "", fmt.Errorf("expected quoted name, got %q", t.text)
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *parser) value() (driver.Value, error) {
+	t := p.peek()
+	if t.param == 0 || t.param > len(p.args) {
+		return nil, fmt.Errorf("expected placeholder, got %q", t.text)
+	}
+	p.pos++
+	return p.args[t.param-1], nil
+}
+
+func (p *parser) names() ([]string, error) {
+	var names []string
+	for {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if !p.accept(",") {
+			return names, nil
+		}
+	}
+}
+
+func (p *parser) table(db *database) (*table, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := db.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("no table %s", name)
+	}
+	return t, nil
+}
+
+// where parses optional condition by column
+func (p *parser) where() (string, driver.Value, error) {
+	if !p.accept("WHERE") {
+		return "", nil, nil
+	}
+	column, err := p.name()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := p.expect("="); err != nil {
+		return "", nil, err
+	}
+	value, err := p.value()
+	return column, value, err
+}
+
+func (p *parser) create(db *database) error {
+	if err := p.expect("TABLE"); err != nil {
+		return err
+	}
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	if _, exists := db.tables[name]; exists {
+		return fmt.Errorf("table %s already exists", name)
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	t := &table{}
+	for {
+		if p.accept("PRIMARY") {
+			if err := p.expect("KEY"); err != nil {
+				return err
+			}
+			if err := p.expect("("); err != nil {
+				return err
+			}
+			if t.key, err = p.names(); err != nil {
+				return err
+			}
+			if err := p.expect(")"); err != nil {
+				return err
+			}
+		} else {
+			column, err := p.name()
+			if err != nil {
+				return err
+			}
+			t.columns = append(t.columns, column)
+			// type and constraints of column
+			depth := 0
+			for p.pos < len(p.tokens) && (depth > 0 || (p.peek().text != "," && p.peek().text != ")")) {
+				switch {
+				case p.accept("("):
+					depth++
+				case p.accept(")"):
+					depth--
+				case p.accept("PRIMARY"):
+					if err := p.expect("KEY"); err != nil {
+						return err
+					}
+					t.key = []string{column}
+				default:
+					p.pos++
+				}
+			}
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+	db.tables[name] = t
+	return nil
+}
+
+func sameKey(t *table, a, b map[string]driver.Value) bool {
+	for _, column := range t.key {
+		if compare(a[column], b[column]) != 0 {
+			return false
+		}
+	}
+	return len(t.key) > 0
+}
+
+func (p *parser) insert(db *database) error {
+	if err := p.expect("INTO"); err != nil {
+		return err
+	}
+	t, err := p.table(db)
+	if err != nil {
+		return err
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	columns, err := p.names()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+	if err := p.expect("VALUES"); err != nil {
+		return err
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	row := make(map[string]driver.Value)
+	for i, column := range columns {
+		if i > 0 {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+		if row[column], err = p.value(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+	if len(row) != len(t.columns) {
+		return fmt.Errorf("expected %d columns, got %d", len(t.columns), len(row))
+	}
+	for _, existing := range t.rows {
+		if sameKey(t, existing, row) {
+			return errors.New("duplicate key")
+		}
+	}
+	t.rows = append(t.rows, row)
+	return nil
+}
+
+func (p *parser) update(db *database) (int64, error) {
+	t, err := p.table(db)
+	if err != nil {
+		return 0, err
+	}
+	if err := p.expect("SET"); err != nil {
+		return 0, err
+	}
+	values := make(map[string]driver.Value)
+	for {
+		column, err := p.name()
+		if err != nil {
+			return 0, err
+		}
+		if err := p.expect("="); err != nil {
+			return 0, err
+		}
+		if values[column], err = p.value(); err != nil {
+			return 0, err
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	column, value, err := p.where()
+	if err != nil {
+		return 0, err
+	}
+	var affected int64
+	for _, row := range t.rows {
+		if column == "" || compare(row[column], value) == 0 {
+			for name, v := range values {
+				row[name] = v
+			}
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func (p *parser) delete(db *database) (int64, error) {
+	if err := p.expect("FROM"); err != nil {
+		return 0, err
+	}
+	t, err := p.table(db)
+	if err != nil {
+		return 0, err
+	}
+	column, value, err := p.where()
+	if err != nil {
+		return 0, err
+	}
+	var kept []map[string]driver.Value
+	for _, row := range t.rows {
+		if column == "" || compare(row[column], value) == 0 {
+			continue
+		}
+		kept = append(kept, row)
+	}
+	affected := int64(len(t.rows) - len(kept))
+	t.rows = kept
+	return affected, nil
+}
+
+func (p *parser) selectRows(db *database) (driver.Rows, error) {
+	columns, err := p.names()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("FROM"); err != nil {
+		return nil, err
+	}
+	t, err := p.table(db)
+	if err != nil {
+		return nil, err
+	}
+	column, value, err := p.where()
+	if err != nil {
+		return nil, err
+	}
+	var order []string
+	if p.accept("ORDER") {
+		if err := p.expect("BY"); err != nil {
+			return nil, err
+		}
+		if order, err = p.names(); err != nil {
+			return nil, err
+		}
+	}
+	var selected []map[string]driver.Value
+	for _, row := range t.rows {
+		if column == "" || compare(row[column], value) == 0 {
+			selected = append(selected, row)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		for _, name := range order {
+			if c := compare(selected[i][name], selected[j][name]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	result := &rows{columns: columns}
+	for _, row := range selected {
+		var values []driver.Value
+		for _, name := range columns {
+			values = append(values, row[name])
+		}
+		result.values = append(result.values, values)
+	}
+	return result, nil
+}
+
+// compare values of the same column
+func compare(a, b driver.Value) int {
+	switch a := a.(type) {
+	case int64:
+		b, _ := b.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case float64:
+		b, _ := b.(float64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case string:
+		b, _ := b.(string)
+		return strings.Compare(a, b)
+	case []byte:
+		b, _ := b.([]byte)
+		return strings.Compare(string(a), string(b))
+	case time.Time:
+		b, _ := b.(time.Time)
+		return a.Compare(b)
+	case bool:
+		b, _ := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		}
+		return 1
+	}
+	if b == nil {
+		return 0
+	}
+	return -1
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSchema checks that each field of schema has resolver method
+func TestSchema(t *testing.T) {
+	resolvers := map[string]reflect.Type{
+		"Query":    reflect.TypeOf(&DataResolver{}),
+		"User":     reflect.TypeOf(&UserResolver{}),
+		"Group":    reflect.TypeOf(&GroupResolver{}),
+		"Transfer": reflect.TypeOf(&TransferResolver{}),
+	}
+	var resolver reflect.Type
+	for _, line := range strings.Split(DataGraphQLSchema, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "type "):
+			resolver = resolvers[strings.Fields(line)[1]]
+		case line == "}" || line == "":
+			resolver = nil
+		case resolver != nil:
+			field := strings.FieldsFunc(line, func(r rune) bool { return r == ':' || r == '(' })[0]
+			found := false
+			for i := 0; i < resolver.NumMethod(); i++ {
+				found = found || strings.EqualFold(resolver.Method(i).Name, field)
+			}
+			if !found {
+				t.Error("no resolver of", field, "in", resolver)
+			}
+		}
+	}
+}
+
+func TestResolvers(t *testing.T) {
+	project := DefaultData()
+	nick := "ally"
+	joined := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	alice, _ := project.InsertUser(&User{Name: "alice", Age: 30, Balance: 1.5, Tags: []string{"a"}, Nick: &nick, Joined: joined})
+	bob, _ := project.InsertUser(&User{Name: "bob"})
+	carol, _ := project.InsertUser(&User{Name: "carol"})
+	project.InsertGroup(&Group{Name: "team", MembersId: []int64{alice.Id, bob.Id}})
+	project.InsertTransfer(&Transfer{FromId: alice.Id, ToId: bob.Id, Amount: 10})
+	project.InsertTransfer(&Transfer{FromId: bob.Id, ToId: carol.Id, Amount: 20})
+	project.InsertTransfer(&Transfer{FromId: alice.Id, ToId: carol.Id, Amount: 30})
+	root := NewDataResolver(project)
+
+	user, err := root.User(struct{ Id string }{"1"})
+	if err != nil || user == nil {
+		t.Fatal(user, err)
+	}
+	if user.Id() != "1" || user.Name() != "alice" || user.Age() != 30 || user.Balance() != 1.5 || len(user.Tags()) != 1 ||
+		*user.Nick() != "ally" || user.Joined() != "2020-01-02T03:04:05Z" {
+		t.Error("fields of user are not resolved")
+	}
+	if missing, err := root.User(struct{ Id string }{"100"}); missing != nil || err != nil {
+		t.Error("missing user:", missing, err)
+	}
+	if _, err := root.User(struct{ Id string }{"abc"}); err == nil {
+		t.Error("invalid id is parsed")
+	}
+
+	transfers := root.Transfers()
+	if len(transfers) != 3 || transfers[0].Amount() != 10 || transfers[0].FromId() != "1" {
+		t.Fatal("transfers are not listed")
+	}
+	// links of the whole list are loaded by the first access
+	if from := transfers[0].From(); from == nil || from.Name() != "alice" {
+		t.Error("from is not resolved")
+	}
+	if batch := transfers[2].batch; len(batch.from) != 2 || batch.fromBatch == nil || len(batch.fromBatch.items) != 2 {
+		t.Error("links are not loaded by batch:", batch.from)
+	}
+	if to := transfers[1].To(); to == nil || to.Name() != "carol" {
+		t.Error("to is not resolved")
+	}
+
+	// nested lists share batches too
+	var amounts []float64
+	users := root.Users()
+	for _, user := range users {
+		for _, transfer := range user.TransfersByFrom() {
+			amounts = append(amounts, transfer.Amount())
+			if transfer.To() == nil {
+				t.Error("nested link is not resolved")
+			}
+		}
+	}
+	if len(amounts) != 3 {
+		t.Error("back references are not resolved:", amounts)
+	}
+	if batch := users[0].batch.transfersByFromBatch; len(batch.to) != 2 {
+		t.Error("nested links are not loaded by batch:", batch.to)
+	}
+	if groups := users[1].GroupsByMembers(); len(groups) != 1 || len(groups[0].Members()) != 2 || groups[0].Members()[1].Name() != "bob" {
+		t.Error("members are not resolved")
+	}
+	if groups := users[2].GroupsByMembers(); groups == nil || len(groups) != 0 {
+		t.Error("empty back references should be empty list")
+	}
+	if ids := root.Groups()[0].MembersId(); len(ids) != 2 || ids[1] != "2" {
+		t.Error("ids of members are not resolved:", ids)
+	}
+}
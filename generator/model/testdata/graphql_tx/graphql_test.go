@@ -0,0 +1,35 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestResolvers(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	tx.InsertUser(&User{Name: "alice", Rank: 3})
+	tx.InsertPost(&Post{AuthorName: "alice", Title: "hello"})
+	tx.Commit()
+	root := NewDataResolver(project)
+
+	user, err := root.User(struct{ Name string }{"alice"})
+	if err != nil || user == nil || user.Name() != "alice" || user.Rank() != 3 {
+		t.Fatal("user is not resolved", user, err)
+	}
+	posts := user.PostsByAuthor()
+	if len(posts) != 1 || posts[0].Id() != "1" || posts[0].AuthorName() != "alice" || posts[0].Author().Name() != "alice" {
+		t.Error("posts are not resolved")
+	}
+
+	// pending changes are not visible and readers of the version don't wait for the writer
+	tx = project.ReadWriteLock()
+	tx.InsertPost(&Post{AuthorName: "alice", Title: "draft"})
+	done := make(chan int)
+	go func() {
+		done <- len(root.Posts())
+	}()
+	if count := <-done; count != 1 {
+		t.Error("pending post is resolved")
+	}
+	tx.Discard()
+}
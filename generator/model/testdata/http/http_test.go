@@ -0,0 +1,115 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func call(t *testing.T, handler http.Handler, method, path, body string, result interface{}) int {
+	t.Helper()
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if result != nil && recorder.Code < 300 {
+		if err := json.Unmarshal(recorder.Body.Bytes(), result); err != nil {
+			t.Fatal(method, path, err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestHandler(t *testing.T) {
+	project := DefaultData()
+	handler := NewDataHandler(project)
+
+	var alice, bob User
+	if code := call(t, handler, "POST", "/users", `{"Name":"alice"}`, &alice); code != http.StatusCreated {
+		t.Fatal("insert:", code)
+	}
+	call(t, handler, "POST", "/users", `{"Name":"bob"}`, &bob)
+	if alice.Id == 0 || bob.Id == alice.Id || project.User(alice.Id) == nil {
+		t.Fatal("not inserted", alice, bob)
+	}
+	if code := call(t, handler, "POST", "/users", `{"Name":"alice"}`, nil); code != http.StatusConflict {
+		t.Error("unique violation:", code)
+	}
+	if code := call(t, handler, "POST", "/users", `{"Name":`, nil); code != http.StatusBadRequest {
+		t.Error("invalid json:", code)
+	}
+
+	var users []User
+	if code := call(t, handler, "GET", "/users", "", &users); code != http.StatusOK || len(users) != 2 || users[0].Name != "alice" || users[1].Name != "bob" {
+		t.Error("list:", code, users)
+	}
+	var user User
+	if code := call(t, handler, "GET", "/users/2", "", &user); code != http.StatusOK || user.Name != "bob" {
+		t.Error("get:", code, user)
+	}
+	if code := call(t, handler, "GET", "/users/100", "", nil); code != http.StatusNotFound {
+		t.Error("get missing:", code)
+	}
+	if code := call(t, handler, "GET", "/users/abc", "", nil); code != http.StatusBadRequest {
+		t.Error("get invalid key:", code)
+	}
+	if code := call(t, handler, "PUT", "/users/2", `{"Name":"robert"}`, &user); code != http.StatusOK || user.Id != 2 || project.User(2).Name != "robert" {
+		t.Error("update:", code, user)
+	}
+	if code := call(t, handler, "PUT", "/users/100", `{"Name":"robert"}`, nil); code != http.StatusNotFound || project.User(100) != nil {
+		t.Error("update missing:", code)
+	}
+
+	// links
+	if code := call(t, handler, "POST", "/transfers", `{"FromId":1,"ToId":2,"Amount":0}`, nil); code != http.StatusUnprocessableEntity {
+		t.Error("validation:", code)
+	}
+	if code := call(t, handler, "POST", "/transfers", `{"FromId":1,"ToId":100,"Amount":10}`, nil); code != http.StatusUnprocessableEntity {
+		t.Error("missing reference:", code)
+	}
+	var inserted Transfer
+	if code := call(t, handler, "POST", "/transfers", `{"FromId":1,"ToId":2,"Amount":10}`, &inserted); code != http.StatusCreated {
+		t.Fatal("insert transfer:", code)
+	}
+	path := "/transfers/" + strconv.FormatInt(inserted.Id, 10)
+	var transfer struct {
+		Id     int64
+		FromId int64
+		Amount int64
+		From   *User
+		To     *User
+	}
+	if code := call(t, handler, "GET", path, "", &transfer); code != http.StatusOK || transfer.Amount != 10 || transfer.From != nil || transfer.To != nil {
+		t.Error("get without expand:", code, transfer)
+	}
+	if code := call(t, handler, "GET", path+"?expand=From,To", "", &transfer); code != http.StatusOK || transfer.From == nil || transfer.From.Name != "alice" || transfer.To == nil || transfer.To.Name != "robert" {
+		t.Error("get with expand:", code, transfer)
+	}
+	if code := call(t, handler, "GET", "/transfers?expand=Amount", "", nil); code != http.StatusBadRequest {
+		t.Error("unknown link:", code)
+	}
+	call(t, handler, "POST", "/groups", `{"Name":"team","MembersId":[1,2]}`, nil)
+	var groups []struct {
+		Name    string
+		Members []*User
+	}
+	if code := call(t, handler, "GET", "/groups?expand=Members", "", &groups); code != http.StatusOK || len(groups) != 1 || len(groups[0].Members) != 2 || groups[0].Members[1].Name != "robert" {
+		t.Error("list with expand:", code, groups)
+	}
+
+	// removal
+	if code := call(t, handler, "DELETE", "/users/1", "", nil); code != http.StatusConflict || project.User(1) == nil {
+		t.Error("restrict violation:", code)
+	}
+	if code := call(t, handler, "DELETE", path, "", nil); code != http.StatusNoContent || project.Transfer(inserted.Id) != nil {
+		t.Error("remove:", code)
+	}
+	if code := call(t, handler, "DELETE", path, "", nil); code != http.StatusNotFound {
+		t.Error("remove missing:", code)
+	}
+	if code := call(t, handler, "DELETE", "/users/1", "", nil); code != http.StatusNoContent || len(project.Group(1).MembersId) != 1 {
+		t.Error("remove with set null:", code)
+	}
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func call(t *testing.T, handler http.Handler, method, path, body string, result interface{}) int {
+	t.Helper()
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if result != nil && recorder.Code < 300 {
+		if err := json.Unmarshal(recorder.Body.Bytes(), result); err != nil {
+			t.Fatal(method, path, err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestHandler(t *testing.T) {
+	project := DefaultData()
+	server := httptest.NewServer(NewDataHandler(project))
+	defer server.Close()
+	handler := NewDataHandler(project)
+
+	var user User
+	if code := call(t, handler, "POST", "/users", `{"Name":"alice"}`, &user); code != http.StatusCreated || user.Version != 1 {
+		t.Fatal("insert:", code, user)
+	}
+	if code := call(t, handler, "PUT", "/users/1", `{"Name":"bob","Version":1}`, &user); code != http.StatusOK || user.Version != 2 {
+		t.Error("update:", code, user)
+	}
+	if code := call(t, handler, "PUT", "/users/1", `{"Name":"carol","Version":1}`, nil); code != http.StatusConflict {
+		t.Error("version conflict:", code)
+	}
+	view := project.ReadLock()
+	if view.User(1).Name != "bob" {
+		t.Error("conflicting update is committed")
+	}
+	view.ReadUnlock()
+
+	// failed writes are discarded
+	if code := call(t, handler, "POST", "/transfers", `{"FromId":100,"Amount":1}`, nil); code != http.StatusUnprocessableEntity {
+		t.Error("missing reference:", code)
+	}
+	var transfers []Transfer
+	if code := call(t, handler, "GET", "/transfers", "", &transfers); code != http.StatusOK || len(transfers) != 0 {
+		t.Error("discarded insert:", code, transfers)
+	}
+
+	// string keys, over real server
+	response, err := http.Post(server.URL+"/tags", "application/json", strings.NewReader(`{"Name":"red label","Color":"red"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusCreated {
+		t.Fatal("insert tag:", response.StatusCode)
+	}
+	response, err = http.Get(server.URL + "/tags/red%20label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tag Tag
+	err = json.NewDecoder(response.Body).Decode(&tag)
+	response.Body.Close()
+	if err != nil || tag.Color != "red" {
+		t.Error("get tag:", err, tag)
+	}
+	if code := call(t, handler, "DELETE", "/tags/red%20label", "", nil); code != http.StatusNoContent {
+		t.Error("remove tag:", code)
+	}
+	view = project.ReadLock()
+	defer view.ReadUnlock()
+	if view.Tag("red label") != nil {
+		t.Error("tag is not removed")
+	}
+}
@@ -0,0 +1,46 @@
+package indexes
+
+import "testing"
+
+func TestSecondaryIndex(t *testing.T) {
+	project := DefaultData()
+	first, _ := project.InsertUser(&User{Name: "first", Email: "user@example.com"})
+	project.InsertUser(&User{Name: "second", Email: "user@example.com"})
+	if items := project.UserByEmail("user@example.com"); len(items) != 2 {
+		t.Fatalf("expected 2 users, got %v", len(items))
+	}
+	first.Email = "first@example.com"
+	project.UpdateUser(first)
+	if items := project.UserByEmail("user@example.com"); len(items) != 1 || items[0].Name != "second" {
+		t.Fatalf("old value should be removed from index: %v", items)
+	}
+	if items := project.UserByEmail("first@example.com"); len(items) != 1 || items[0].Id != first.Id {
+		t.Fatalf("new value should be indexed: %v", items)
+	}
+	project.RemoveUser(first.Id)
+	if items := project.UserByEmail("first@example.com"); len(items) != 0 {
+		t.Fatalf("removed item should not be indexed: %v", items)
+	}
+}
+
+func TestSecondaryIndexRestore(t *testing.T) {
+	storage := NewMapUserStorage()
+	storage.PutUser(1, &User{Id: 1, Name: "first", Email: "user@example.com"})
+	project := NewData(storage, NewMapTransferStorage())
+	if items := project.UserByName("first"); len(items) != 1 || items[0].Id != 1 {
+		t.Fatalf("index should be restored from storage: %v", items)
+	}
+}
+
+func TestSecondaryIndexOrder(t *testing.T) {
+	project := DefaultData()
+	for i := 0; i < 50; i++ {
+		project.InsertUser(&User{Name: "user"})
+	}
+	items := project.UserByName("user")
+	for i := 1; i < len(items); i++ {
+		if items[i-1].Id >= items[i].Id {
+			t.Fatalf("items are not in order of keys: %v, %v", items[i-1].Id, items[i].Id)
+		}
+	}
+}
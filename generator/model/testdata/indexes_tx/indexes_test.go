@@ -0,0 +1,75 @@
+package indexes
+
+import "testing"
+
+func TestSecondaryIndex(t *testing.T) {
+	storage := NewMapDataStorage()
+	project := NewData(storage)
+
+	tx := project.ReadWriteLock()
+	first, _ := tx.InsertUser(&User{Name: "first", Email: "user@example.com"})
+	tx.InsertUser(&User{Name: "second", Email: "user@example.com"})
+	tx.Commit()
+
+	view := project.ReadLock()
+	if items := view.UserByEmail("user@example.com"); len(items) != 2 {
+		t.Fatalf("expected 2 users, got %v", len(items))
+	}
+	view.ReadUnlock()
+
+	tx = project.ReadWriteLock()
+	changed := *first
+	changed.Email = "first@example.com"
+	tx.UpdateUser(&changed)
+	tx.Commit()
+
+	view = project.ReadLock()
+	if items := view.UserByEmail("first@example.com"); len(items) != 1 || items[0].Name != "first" {
+		t.Fatalf("new value should be indexed: %v", items)
+	}
+	view.ReadUnlock()
+
+	// indexes should be restored from storage
+	view = NewData(storage).ReadLock()
+	if items := view.UserByEmail("user@example.com"); len(items) != 1 || items[0].Name != "second" {
+		t.Fatalf("index should be restored: %v", items)
+	}
+	view.ReadUnlock()
+
+	tx = project.ReadWriteLock()
+	tx.RemoveUser(first.Id)
+	tx.Commit()
+	view = project.ReadLock()
+	if items := view.UserByName("first"); len(items) != 0 {
+		t.Fatalf("removed item should not be indexed: %v", items)
+	}
+	view.ReadUnlock()
+}
+
+func TestSecondaryIndexOrder(t *testing.T) {
+	project := NewData(NewMapDataStorage())
+	tx := project.ReadWriteLock()
+	for i := 0; i < 25; i++ {
+		tx.InsertUser(&User{Name: "user"})
+	}
+	tx.Commit()
+	// committed and pending keys are merged in order
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	for i := 0; i < 25; i++ {
+		tx.InsertUser(&User{Name: "user"})
+	}
+	first := tx.UserByName("user")[0]
+	changed := *first
+	changed.Email = "first@example.com"
+	tx.UpdateUser(&changed)
+	items := tx.UserByName("user")
+	if len(items) != 50 {
+		t.Fatalf("expected 50 users, got %v", len(items))
+	}
+	for i := 1; i < len(items); i++ {
+		if items[i-1].Id >= items[i].Id {
+			t.Fatalf("items are not in order of keys: %v, %v", items[i-1].Id, items[i].Id)
+		}
+	}
+}
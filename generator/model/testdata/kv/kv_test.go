@@ -0,0 +1,90 @@
+package kvstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(item interface{}) ([]byte, error) { return json.Marshal(item) }
+
+func (jsonCodec) Unmarshal(data []byte, item interface{}) error { return json.Unmarshal(data, item) }
+
+func TestKVStorage(t *testing.T) {
+	for name, codec := range map[string]DataCodec{"binary": nil, "json": jsonCodec{}} {
+		t.Run(name, func(t *testing.T) {
+			kv := NewMemoryDataKV()
+			storage := NewKVDataStorage(kv, codec)
+			project := NewData(storage, storage)
+			alice, err := project.InsertUser(&User{Name: "alice", Tags: []string{"admin"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			bob, _ := project.InsertUser(&User{Name: "bob", FriendsId: []int64{alice.Id}})
+			carol, _ := project.InsertUser(&User{Name: "carol"})
+			if _, err := project.InsertUserGroup(&UserGroup{Name: "team", MembersId: []int64{bob.Id, carol.Id}}); err != nil {
+				t.Fatal(err)
+			}
+			if err := project.RemoveUser(carol.Id); err != nil {
+				t.Fatal(err)
+			}
+
+			// state is read from engine by other project
+			restoredStorage := NewKVDataStorage(kv, codec)
+			restored := NewData(restoredStorage, restoredStorage)
+			if user := restored.User(alice.Id); user == nil || user.Name != "alice" || len(user.Tags) != 1 || user.Tags[0] != "admin" {
+				t.Fatalf("user not restored: %+v", user)
+			}
+			if restored.User(carol.Id) != nil {
+				t.Fatal("removed user restored")
+			}
+			if friends := restored.User(bob.Id).Friends(); len(friends) != 1 || friends[0].Name != "alice" {
+				t.Fatalf("links not restored: %v", friends)
+			}
+			if members := restored.UserGroup("team").Members(); len(members) != 1 || members[0].Name != "bob" {
+				t.Fatalf("links not updated: %v", members)
+			}
+			if users := restored.UserByName("bob"); len(users) != 1 {
+				t.Fatalf("index not restored: %v", users)
+			}
+			if next, _ := restored.InsertUser(&User{Name: "dave"}); next.Id != bob.Id+1 {
+				t.Fatalf("sequence not restored: %d", next.Id)
+			}
+		})
+	}
+}
+
+func TestKeyOrder(t *testing.T) {
+	storage := NewKVDataStorage(NewMemoryDataKV(), nil)
+	for _, id := range []int64{3, -300, 1 << 40, -1, 0} {
+		storage.PutUser(id, &User{Id: id})
+	}
+	// prefix of other model doesn't match items of model with shorter name
+	storage.PutUserGroup("b", &UserGroup{Name: "b"})
+	storage.PutUserGroup("a", &UserGroup{Name: "a"})
+	var ids []int64
+	storage.IterateUser(func(id int64, item *User) bool {
+		ids = append(ids, id)
+		return true
+	})
+	if len(ids) != 5 || ids[0] != -300 || ids[1] != -1 || ids[2] != 0 || ids[3] != 3 || ids[4] != 1<<40 {
+		t.Fatalf("unexpected order %v", ids)
+	}
+	var names []string
+	storage.IterateUserGroup(func(name string, item *UserGroup) bool {
+		names = append(names, name)
+		return true
+	})
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Fatalf("unexpected order %v", names)
+	}
+	if bytes.Compare(kvDataUserKey(-1), kvDataUserKey(1)) >= 0 {
+		t.Fatal("negative key after positive")
+	}
+	storage.DeleteUser(-1)
+	if storage.GetUser(-1) != nil || storage.GetUser(0) == nil {
+		t.Fatal("unexpected delete")
+	}
+}
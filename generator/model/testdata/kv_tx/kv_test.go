@@ -0,0 +1,69 @@
+package kvstore
+
+import (
+	"testing"
+)
+
+func TestKVTxStorage(t *testing.T) {
+	kv := NewMemoryDataKV()
+	project := NewData(NewKVDataStorage(kv, nil))
+	tx := project.ReadWriteLock()
+	_, _ = tx.InsertAccount(&Account{Name: "bob", Balance: 5})
+	_, _ = tx.InsertAccount(&Account{Name: "alice", Balance: 10})
+	entry, err := tx.InsertEntry(&Entry{AccountName: "bob", Amount: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+	// discarded changes are not written
+	tx = project.ReadWriteLock()
+	_ = tx.RemoveAccount("bob")
+	tx.Discard()
+	tx = project.ReadWriteLock()
+	alice := *tx.Account("alice")
+	alice.Balance = 20
+	if _, err := tx.UpdateAccount(&alice); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+
+	restored := NewData(NewKVDataStorage(kv, nil))
+	view := restored.ReadLock()
+	defer view.ReadUnlock()
+	if account := view.Account("alice"); account == nil || account.Balance != 20 {
+		t.Fatalf("account not restored: %+v", account)
+	}
+	if restoredEntry := view.Entry(entry.Id); restoredEntry == nil || restoredEntry.Account().Name != "bob" {
+		t.Fatalf("entry not restored: %+v", restoredEntry)
+	}
+	var names []string
+	view.AccountRange("a", "z", func(item *Account) bool {
+		names = append(names, item.Name)
+		return true
+	})
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Fatalf("unexpected range %v", names)
+	}
+}
+
+func TestCascadeDelete(t *testing.T) {
+	kv := NewMemoryDataKV()
+	project := NewData(NewKVDataStorage(kv, nil))
+	tx := project.ReadWriteLock()
+	_, _ = tx.InsertAccount(&Account{Name: "bob"})
+	_, _ = tx.InsertEntry(&Entry{AccountName: "bob", Amount: 3})
+	tx.Commit()
+	tx = project.ReadWriteLock()
+	if err := tx.RemoveAccount("bob"); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+	var keys int
+	kv.Scan(nil, func(key, value []byte) bool {
+		keys++
+		return true
+	})
+	if keys != 0 {
+		t.Fatalf("%d keys left after cascade delete", keys)
+	}
+}
@@ -0,0 +1,105 @@
+package mvcc
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSnapshotReads(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertAccount(&Account{Owner: "alice", Balance: 10})
+	bob, _ := tx.InsertAccount(&Account{Owner: "bob", Balance: 20})
+	tx.Commit()
+
+	view := project.ReadLock()
+	// writer is not blocked by the reader
+	tx = project.ReadWriteLock()
+	changed := *alice
+	changed.Owner = "carol"
+	changed.Balance = 30
+	if _, err := tx.UpdateAccount(&changed); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.RemoveAccount(bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	dave, _ := tx.InsertAccount(&Account{Owner: "dave", Balance: 5})
+	tx.Commit()
+
+	// the view keeps state of its version
+	if item := view.Account(alice.Id); item == nil || item.Owner != "alice" {
+		t.Fatalf("unexpected item in view %v", item)
+	}
+	if item := view.Account(bob.Id); item == nil {
+		t.Fatal("removed item is not visible in view")
+	}
+	if item := view.Account(dave.Id); item != nil {
+		t.Fatalf("new item is visible in view %v", item)
+	}
+	if items := view.AccountByOwner("carol"); len(items) != 0 {
+		t.Fatalf("new value is visible in view %v", items)
+	}
+	if items := view.AccountLastByBalance(); len(items) != 1 || items[0].Id != bob.Id {
+		t.Fatalf("unexpected last by balance in view %v", items)
+	}
+	var owners []string
+	view.AccountRange(0, dave.Id+1, func(item *Account) bool {
+		owners = append(owners, item.Owner)
+		return true
+	})
+	if len(owners) != 2 || owners[0] != "alice" || owners[1] != "bob" {
+		t.Fatalf("unexpected range in view %v", owners)
+	}
+	if item := view.AccountLast(); item == nil || item.Id != bob.Id {
+		t.Fatalf("unexpected last in view %v", item)
+	}
+
+	// new reader sees last commit
+	latest := project.ReadLock()
+	if item := latest.Account(alice.Id); item == nil || item.Owner != "carol" {
+		t.Fatalf("unexpected item %v", item)
+	}
+	if items := latest.AccountFirstByBalance(); len(items) != 1 || items[0].Id != dave.Id {
+		t.Fatalf("unexpected first by balance %v", items)
+	}
+	versions := project.(*implData)._versions
+	if len(versions.views) != 2 {
+		t.Fatalf("expected 2 views, got %d", len(versions.views))
+	}
+	view.ReadUnlock()
+	latest.ReadUnlock()
+	if len(versions.views) != 0 {
+		t.Fatalf("views are not reclaimed: %d", len(versions.views))
+	}
+}
+
+func TestConcurrentReaders(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	account, _ := tx.InsertAccount(&Account{Owner: "alice"})
+	tx.Commit()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				view := project.ReadLock()
+				first := view.Account(account.Id).Balance
+				if second := view.Account(account.Id).Balance; first != second {
+					t.Errorf("non-repeatable read: %d != %d", first, second)
+				}
+				view.ReadUnlock()
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		tx := project.ReadWriteLock()
+		changed := *tx.Account(account.Id)
+		changed.Balance++
+		_, _ = tx.UpdateAccount(&changed)
+		tx.Commit()
+	}
+	wg.Wait()
+}
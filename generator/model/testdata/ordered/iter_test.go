@@ -0,0 +1,31 @@
+package ordered
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIteration(t *testing.T) {
+	project := DefaultData()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		project.InsertEvent(&Event{Name: name})
+	}
+	var got []string
+	for id, item := range project.IterEvent() {
+		if id != item.Id {
+			t.Fatalf("unexpected key %d of %v", id, item)
+		}
+		if item.Name == "c" {
+			break
+		}
+		got = append(got, item.Name)
+		// loop body could modify the project
+		project.InsertEvent(&Event{Name: item.Name + item.Name})
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("unexpected sequence: %v", got)
+	}
+	if count := project.CountEvent(); count != 6 {
+		t.Fatalf("unexpected count %d", count)
+	}
+}
@@ -0,0 +1,41 @@
+package ordered
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListing(t *testing.T) {
+	project := DefaultData()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		project.InsertEvent(&Event{Name: name})
+	}
+	project.RemoveEvent(3)
+	if got := names(project.AllEvents()); !reflect.DeepEqual(got, []string{"a", "b", "d", "e"}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	if count := project.CountEvent(); count != 4 {
+		t.Fatalf("unexpected count %d", count)
+	}
+	if !project.HasEvent(2) || project.HasEvent(3) {
+		t.Fatal("unexpected existence")
+	}
+	var pages [][]string
+	var cursor *int64
+	for {
+		var items []*Event
+		items, cursor = project.ListEvent(cursor, 3)
+		pages = append(pages, names(items))
+		if cursor == nil {
+			break
+		}
+	}
+	if !reflect.DeepEqual(pages, [][]string{{"a", "b", "d"}, {"e"}}) {
+		t.Fatalf("unexpected pages: %v", pages)
+	}
+	// cursor is not an existing key
+	removed := int64(3)
+	if items, next := project.ListEvent(&removed, 0); !reflect.DeepEqual(names(items), []string{"d", "e"}) || next != nil {
+		t.Fatalf("unexpected page after removed key: %v %v", names(items), next)
+	}
+}
@@ -0,0 +1,105 @@
+package ordered
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func names(items []*Event) []string {
+	var result []string
+	for _, item := range items {
+		result = append(result, item.Name)
+	}
+	return result
+}
+
+func TestRangeByKey(t *testing.T) {
+	project := DefaultData()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		project.InsertEvent(&Event{Name: name})
+	}
+	project.RemoveEvent(3)
+	var items []*Event
+	project.EventRange(2, 5, func(item *Event) bool {
+		items = append(items, item)
+		return true
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"b", "d"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	items = nil
+	project.EventRange(-1<<63, 1<<63-1, func(item *Event) bool {
+		items = append(items, item)
+		return len(items) < 2
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	if project.EventFirst().Name != "a" || project.EventLast().Name != "e" {
+		t.Fatal("unexpected first or last")
+	}
+	if project.EventFloor(3).Name != "b" || project.EventCeiling(3).Name != "d" || project.EventFloor(0) != nil || project.EventCeiling(6) != nil {
+		t.Fatal("unexpected floor or ceiling")
+	}
+}
+
+func TestRangeByIndex(t *testing.T) {
+	project := DefaultData()
+	project.InsertEvent(&Event{Name: "a", Time: 30})
+	project.InsertEvent(&Event{Name: "b", Time: 10})
+	project.InsertEvent(&Event{Name: "c", Time: 20})
+	project.InsertEvent(&Event{Name: "d", Time: 10})
+	moved, _ := project.InsertEvent(&Event{Name: "e", Time: 40})
+	moved.Time = 5
+	project.UpdateEvent(moved)
+
+	var items []*Event
+	project.EventRangeByTime(10, 30, func(item *Event) bool {
+		items = append(items, item)
+		return true
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"b", "d", "c"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	if got := names(project.EventFirstByTime()); !reflect.DeepEqual(got, []string{"e"}) {
+		t.Fatalf("unexpected first: %v", got)
+	}
+	if got := names(project.EventLastByTime()); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Fatalf("unexpected last: %v", got)
+	}
+	if got := names(project.EventFloorByTime(15)); !reflect.DeepEqual(got, []string{"b", "d"}) {
+		t.Fatalf("unexpected floor: %v", got)
+	}
+	if got := names(project.EventCeilingByTime(35)); got != nil {
+		t.Fatalf("unexpected ceiling: %v", got)
+	}
+}
+
+func TestWriteInRange(t *testing.T) {
+	project := DefaultData()
+	for i := int64(1); i <= 3; i++ {
+		project.InsertEvent(&Event{Name: "event", Time: i})
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// items are passed after unlock: iterator could write to the project
+		project.EventRange(1, 4, func(item *Event) bool {
+			project.InsertEvent(&Event{Name: "copy", Time: item.Time + 10})
+			return true
+		})
+		project.EventRangeByTime(1, 4, func(item *Event) bool {
+			project.RemoveEvent(item.Id)
+			return true
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("write in range iterator is blocked")
+	}
+	if items := project.AllEvents(); len(items) != 3 || items[0].Name != "copy" {
+		t.Fatalf("unexpected events: %v", names(items))
+	}
+}
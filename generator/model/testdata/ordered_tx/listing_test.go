@@ -0,0 +1,38 @@
+package ordered
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListing(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		tx.InsertEvent(&Event{Name: name})
+	}
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	tx.RemoveEvent(2)
+	tx.InsertEvent(&Event{Name: "e"})
+	// pending changes are visible in the transaction
+	if got := names(tx.AllEvents()); !reflect.DeepEqual(got, []string{"a", "c", "d", "e"}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	if count := tx.CountEvent(); count != 4 {
+		t.Fatalf("unexpected count %d", count)
+	}
+	if tx.HasEvent(2) || !tx.HasEvent(5) {
+		t.Fatal("unexpected existence")
+	}
+	items, cursor := tx.ListEvent(nil, 2)
+	if !reflect.DeepEqual(names(items), []string{"a", "c"}) || cursor == nil || *cursor != 3 {
+		t.Fatalf("unexpected first page: %v %v", names(items), cursor)
+	}
+	items, cursor = tx.ListEvent(cursor, 2)
+	if !reflect.DeepEqual(names(items), []string{"d", "e"}) || cursor != nil {
+		t.Fatalf("unexpected last page: %v %v", names(items), cursor)
+	}
+}
@@ -0,0 +1,111 @@
+package ordered
+
+import (
+	"reflect"
+	"testing"
+)
+
+func names(items []*Event) []string {
+	var result []string
+	for _, item := range items {
+		result = append(result, item.Name)
+	}
+	return result
+}
+
+func TestRange(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		tx.InsertEvent(&Event{Name: name, Time: int64(10 - i)})
+	}
+	tx.RemoveEvent(3)
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	var items []*Event
+	view.EventRange(2, 5, func(item *Event) bool {
+		items = append(items, item)
+		return true
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"b", "d"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	if view.EventFirst().Name != "a" || view.EventLast().Name != "e" {
+		t.Fatal("unexpected first or last")
+	}
+	if view.EventFloor(3).Name != "b" || view.EventCeiling(3).Name != "d" || view.EventCeiling(6) != nil {
+		t.Fatal("unexpected floor or ceiling")
+	}
+	items = nil
+	view.EventRangeByTime(0, 10, func(item *Event) bool {
+		items = append(items, item)
+		return true
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"e", "d", "b"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	if got := names(view.EventCeilingByTime(8)); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Fatalf("unexpected ceiling: %v", got)
+	}
+}
+
+func TestRangeWithPending(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		tx.InsertEvent(&Event{Name: name})
+	}
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	tx.RemoveEvent(1)
+	tx.RemoveEvent(3)
+	tx.UpdateEvent(&Event{Id: 4, Name: "D"})
+	tx.InsertEvent(&Event{Name: "f"})
+	var items []*Event
+	tx.EventRange(0, 10, func(item *Event) bool {
+		items = append(items, item)
+		return true
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"b", "D", "e", "f"}) {
+		t.Fatalf("unexpected range: %v", got)
+	}
+	items = nil
+	tx.EventRange(0, 10, func(item *Event) bool {
+		items = append(items, item)
+		return len(items) < 2
+	})
+	if got := names(items); !reflect.DeepEqual(got, []string{"b", "D"}) {
+		t.Fatalf("unexpected stopped range: %v", got)
+	}
+	if tx.EventFirst().Name != "b" || tx.EventLast().Name != "f" {
+		t.Fatal("unexpected first or last")
+	}
+	if tx.EventFloor(3).Name != "b" || tx.EventCeiling(3).Name != "D" || tx.EventFloor(1) != nil || tx.EventCeiling(7) != nil {
+		t.Fatal("unexpected floor or ceiling")
+	}
+}
+
+func TestWriteInRange(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	for i := int64(1); i <= 3; i++ {
+		tx.InsertEvent(&Event{Name: "event", Time: i})
+	}
+	// items are collected before the iterator is called: writes don't change the walk
+	tx.EventRange(1, 4, func(item *Event) bool {
+		tx.InsertEvent(&Event{Name: "copy", Time: item.Time + 10})
+		return true
+	})
+	tx.EventRangeByTime(1, 4, func(item *Event) bool {
+		tx.RemoveEvent(item.Id)
+		return true
+	})
+	if items := tx.AllEvents(); len(items) != 3 || items[0].Name != "copy" {
+		t.Fatalf("unexpected events: %v", names(items))
+	}
+}
@@ -0,0 +1,98 @@
+package overlay
+
+import (
+	"testing"
+)
+
+func names(items []*Event) map[string]bool {
+	result := make(map[string]bool)
+	for _, item := range items {
+		result[item.Name] = true
+	}
+	return result
+}
+
+func TestReadYourWrites(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	a, _ := tx.InsertEvent(&Event{Name: "a", Time: 10})
+	b, _ := tx.InsertEvent(&Event{Name: "b", Time: 20})
+	c, _ := tx.InsertEvent(&Event{Name: "c", Time: 30})
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	d, _ := tx.InsertEvent(&Event{Name: "d", Time: 5})
+	if err := tx.RemoveEvent(c.Id); err != nil {
+		t.Fatal(err)
+	}
+	changed := *b
+	changed.Name = "bb"
+	changed.Time = 40
+	if _, err := tx.UpdateEvent(&changed); err != nil {
+		t.Fatal(err)
+	}
+	// by key
+	if item := tx.Event(d.Id); item == nil || item.Name != "d" {
+		t.Fatalf("inserted item is not visible: %v", item)
+	}
+	if item := tx.Event(c.Id); item != nil {
+		t.Fatalf("removed item is visible: %v", item)
+	}
+	if item := tx.Event(b.Id); item == nil || item.Name != "bb" {
+		t.Fatalf("updated item is not visible: %v", item)
+	}
+	// by index
+	if items := tx.EventByName("b"); len(items) != 0 {
+		t.Fatalf("old value is visible: %v", items)
+	}
+	if items := tx.EventByName("bb"); len(items) != 1 {
+		t.Fatalf("new value is not visible: %v", items)
+	}
+	// range by key
+	var keys []int64
+	tx.EventRange(0, 100, func(item *Event) bool {
+		keys = append(keys, item.Id)
+		return true
+	})
+	if len(keys) != 3 || keys[0] != a.Id || keys[1] != b.Id || keys[2] != d.Id {
+		t.Fatalf("unexpected range %v", keys)
+	}
+	if item := tx.EventLast(); item == nil || item.Id != d.Id {
+		t.Fatalf("unexpected last %v", item)
+	}
+	if item := tx.EventCeiling(c.Id); item == nil || item.Id != d.Id {
+		t.Fatalf("unexpected ceiling %v", item)
+	}
+	// range by ordered index
+	var times []int64
+	tx.EventRangeByTime(0, 100, func(item *Event) bool {
+		times = append(times, item.Time)
+		return true
+	})
+	if len(times) != 3 || times[0] != 5 || times[1] != 10 || times[2] != 40 {
+		t.Fatalf("unexpected range by time %v", times)
+	}
+	if items := tx.EventFirstByTime(); !names(items)["d"] || len(items) != 1 {
+		t.Fatalf("unexpected first by time %v", items)
+	}
+	if items := tx.EventLastByTime(); !names(items)["bb"] || len(items) != 1 {
+		t.Fatalf("unexpected last by time %v", items)
+	}
+	if items := tx.EventFloorByTime(35); !names(items)["a"] || len(items) != 1 {
+		t.Fatalf("unexpected floor by time %v", items)
+	}
+	if items := tx.EventCeilingByTime(15); !names(items)["bb"] || len(items) != 1 {
+		t.Fatalf("unexpected ceiling by time %v", items)
+	}
+	// same value in committed and pending state
+	if _, err := tx.InsertEvent(&Event{Name: "e", Time: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if items := tx.EventFirstByTime(); len(items) != 1 {
+		t.Fatalf("unexpected first by time %v", items)
+	}
+	if items := tx.EventFloorByTime(10); !names(items)["a"] || !names(items)["e"] {
+		t.Fatalf("unexpected floor by time %v", items)
+	}
+}
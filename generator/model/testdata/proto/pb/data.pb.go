@@ -0,0 +1,25 @@
+// Package pb imitates messages generated by protoc-gen-go from data.proto
+package pb
+
+type User struct {
+	Active   bool
+	Age      int64
+	Avatar   []byte
+	HttpPort uint32
+	Id       int64
+	Limits   map[string]int64
+	Name     string
+	Score    float32
+	Tags     []string
+}
+
+type Group struct {
+	Id        int64
+	MembersId []int64
+}
+
+type Transfer struct {
+	Amount float64
+	FromId int64
+	Id     int64
+}
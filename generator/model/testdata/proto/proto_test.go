@@ -0,0 +1,52 @@
+package proto
+
+import (
+	"example.com/proto/pb"
+	"reflect"
+	"testing"
+)
+
+func TestConversion(t *testing.T) {
+	user := &User{
+		Id:       1,
+		Name:     "alice",
+		Age:      30,
+		HTTPPort: 8080,
+		Score:    0.5,
+		Active:   true,
+		Avatar:   []byte{1, 2},
+		Tags:     []string{"a", "b"},
+		Limits:   map[string]int{"daily": 10},
+	}
+	message := UserToProto(user)
+	expected := &pb.User{Id: 1, Name: "alice", Age: 30, HttpPort: 8080, Score: 0.5, Active: true, Avatar: []byte{1, 2}, Tags: []string{"a", "b"}, Limits: map[string]int64{"daily": 10}}
+	if !reflect.DeepEqual(message, expected) {
+		t.Errorf("%+v != %+v", message, expected)
+	}
+	if restored := UserFromProto(message); !reflect.DeepEqual(restored, user) {
+		t.Errorf("%+v != %+v", restored, user)
+	}
+
+	group := &Group{Id: 2, MembersId: []int64{1, 3}}
+	if message := GroupToProto(group); !reflect.DeepEqual(message.MembersId, []int64{1, 3}) {
+		t.Error("members are not converted", message)
+	} else if restored := GroupFromProto(message); !reflect.DeepEqual(restored, group) {
+		t.Errorf("%+v != %+v", restored, group)
+	}
+	transfer := TransferFromProto(&pb.Transfer{Id: 3, FromId: 1, Amount: 1.5})
+	if transfer.Id != 3 || transfer.FromId != 1 || transfer.Amount != 1.5 {
+		t.Error("transfer is not converted", transfer)
+	}
+
+	if UserToProto(nil) != nil || UserFromProto(nil) != nil {
+		t.Error("nil is converted")
+	}
+}
+
+func TestConversionOfStored(t *testing.T) {
+	project := DefaultData()
+	user, _ := project.InsertUser(UserFromProto(&pb.User{Name: "bob"}))
+	if message := UserToProto(project.User(user.Id)); message.Name != "bob" || message.Id != user.Id {
+		t.Error("stored item is not converted", message)
+	}
+}
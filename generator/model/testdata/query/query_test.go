@@ -0,0 +1,86 @@
+package query
+
+import (
+	"testing"
+	"time"
+)
+
+func names(users []*User) []string {
+	var result []string
+	for _, user := range users {
+		result = append(result, user.Name)
+	}
+	return result
+}
+
+func equal(t *testing.T, got []string, expected ...string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func fill(t *testing.T) (Data, []*User) {
+	project := DefaultData()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var users []*User
+	for i, name := range []string{"alice", "bob", "carol", "dave", "bob"} {
+		user, err := project.InsertUser(&User{Name: name, Age: 20 + i%3*10, Active: i%2 == 0, Joined: start.AddDate(0, i, 0)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	return project, users
+}
+
+func TestQuery(t *testing.T) {
+	project, users := fill(t)
+	equal(t, names(project.Users().All()), "alice", "bob", "carol", "dave", "bob")
+	equal(t, names(project.Users().Where(UserName.Eq("bob")).All()), "bob", "bob")
+	equal(t, names(project.Users().Where(UserName.Eq("bob")).OrderBy(UserId.Desc()).Limit(1).All()), "bob")
+	if first := project.Users().Where(UserName.Eq("bob")).OrderBy(UserId.Desc()).First(); first != users[4] {
+		t.Fatalf("unexpected first %+v", first)
+	}
+	equal(t, names(project.Users().OrderBy(UserId.Desc()).Limit(2).All()), "bob", "dave")
+	equal(t, names(project.Users().Where(UserId.Gt(users[1].Id), UserId.Le(users[3].Id)).All()), "carol", "dave")
+	equal(t, names(project.Users().Where(UserId.Lt(users[2].Id)).All()), "alice", "bob")
+	equal(t, names(project.Users().Where(UserId.Between(users[1].Id, users[3].Id)).All()), "bob", "carol")
+	// ages: 20, 30, 40, 20, 30
+	equal(t, names(project.Users().Where(UserAge.Between(25, 40)).All()), "bob", "bob")
+	equal(t, names(project.Users().Where(UserAge.Ge(30), UserActive.Eq(true)).All()), "carol", "bob")
+	equal(t, names(project.Users().OrderBy(UserAge.Desc(), UserName.Asc()).Limit(3).All()), "carol", "bob", "bob")
+	equal(t, names(project.Users().Where(UserJoined.Lt(users[2].Joined)).All()), "alice", "bob")
+	if count := project.Users().Where(UserName.Ne("bob")).Count(); count != 3 {
+		t.Fatalf("unexpected count %d", count)
+	}
+	if project.Users().Where(UserId.Eq(100)).First() != nil {
+		t.Fatal("unexpected user")
+	}
+	// query is immutable
+	base := project.Users().Where(UserActive.Eq(true))
+	equal(t, names(base.Where(UserName.Eq("alice")).All()), "alice")
+	equal(t, names(base.All()), "alice", "carol", "bob")
+}
+
+func TestQueryLinks(t *testing.T) {
+	project, users := fill(t)
+	alice, bob := *users[0], *users[1]
+	alice.FriendsId = []int64{bob.Id}
+	if _, err := project.UpdateUser(&alice); err != nil {
+		t.Fatal(err)
+	}
+	equal(t, names(project.Users().Where(UserFriendsId.Contains(bob.Id)).All()), "alice")
+	_, _ = project.InsertPost(&Post{AuthorId: bob.Id, Title: "first"})
+	_, _ = project.InsertPost(&Post{AuthorId: alice.Id, Title: "second"})
+	_, _ = project.InsertPost(&Post{AuthorId: bob.Id, Title: "third"})
+	posts := project.Posts().Where(PostAuthorId.Eq(bob.Id)).OrderBy(PostTitle.Desc()).All()
+	if len(posts) != 2 || posts[0].Title != "third" || posts[1].Title != "first" {
+		t.Fatalf("unexpected posts %v", posts)
+	}
+}
@@ -0,0 +1,56 @@
+package query
+
+import (
+	"testing"
+)
+
+func names(accounts []*Account) []string {
+	var result []string
+	for _, account := range accounts {
+		result = append(result, account.Name)
+	}
+	return result
+}
+
+func equal(t *testing.T, got []string, expected ...string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestQueryTx(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	_, _ = tx.InsertAccount(&Account{Name: "alice", Balance: 10, Group: "a"})
+	_, _ = tx.InsertAccount(&Account{Name: "bob", Balance: 20, Group: "b"})
+	_, _ = tx.InsertAccount(&Account{Name: "carol", Balance: 30, Group: "a"})
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	tx = project.ReadWriteLock()
+	_ = tx.RemoveAccount("alice")
+	bob := *tx.Account("bob")
+	bob.Group = "a"
+	bob.Balance = 35
+	_, _ = tx.UpdateAccount(&bob)
+	_, _ = tx.InsertAccount(&Account{Name: "dave", Balance: 15, Group: "a"})
+	// pending changes are visible in the transaction
+	equal(t, names(tx.Accounts().Where(AccountGroup.Eq("a")).All()), "bob", "carol", "dave")
+	equal(t, names(tx.Accounts().Where(AccountBalance.Between(15, 35)).OrderBy(AccountBalance.Asc()).All()), "dave", "carol")
+	equal(t, names(tx.Accounts().OrderBy(AccountName.Desc()).Limit(2).All()), "dave", "carol")
+	equal(t, names(tx.Accounts().Where(AccountName.Ge("b"), AccountName.Lt("d")).All()), "bob", "carol")
+	// view sees committed state
+	equal(t, names(view.Accounts().Where(AccountGroup.Eq("a")).All()), "alice", "carol")
+	tx.Commit()
+	equal(t, names(view.Accounts().All()), "alice", "bob", "carol")
+	reader := project.ReadLock()
+	defer reader.ReadUnlock()
+	equal(t, names(reader.Accounts().Where(AccountBalance.Gt(20)).OrderBy(AccountBalance.Desc()).All()), "bob", "carol")
+}
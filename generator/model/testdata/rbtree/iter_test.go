@@ -0,0 +1,41 @@
+package rbtree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIteration(t *testing.T) {
+	tags := NewTreeTagStorage()
+	for _, name := range []string{"charlie", "alpha", "delta", "bravo"} {
+		tags.PutTag(name, &Tag{Name: name})
+	}
+	var names []string
+	tags.IterateTag(func(name string, item *Tag) bool {
+		names = append(names, name)
+		return name != "bravo"
+	})
+	if !reflect.DeepEqual(names, []string{"alpha", "bravo"}) {
+		t.Fatalf("iteration is not stopped: %v", names)
+	}
+	names = nil
+	for name := range tags.(*treeTagStorage).IterTag() {
+		if name == "delta" {
+			break
+		}
+		names = append(names, name)
+	}
+	if !reflect.DeepEqual(names, []string{"alpha", "bravo", "charlie"}) {
+		t.Fatalf("unexpected sequence: %v", names)
+	}
+	names = nil
+	for name, item := range tags.(*treeTagStorage).data.Backward() {
+		if item.Name != name {
+			t.Fatalf("unexpected item %v of %s", item, name)
+		}
+		names = append(names, name)
+	}
+	if !reflect.DeepEqual(names, []string{"delta", "charlie", "bravo", "alpha"}) {
+		t.Fatalf("unexpected backward sequence: %v", names)
+	}
+}
@@ -0,0 +1,60 @@
+package rbtree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedStorage(t *testing.T) {
+	users := NewTreeUserStorage()
+	tags := NewTreeTagStorage()
+	project := NewData(users, tags)
+	user, _ := project.InsertUser(&User{Name: "user"})
+	for _, name := range []string{"delta", "alpha", "charlie", "bravo", "echo"} {
+		if _, err := project.InsertTag(&Tag{Name: name, UserId: user.Id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	project.RemoveTag("charlie")
+	if _, err := project.UpdateTag(&Tag{Name: "echo"}); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	tags.IterateTag(func(name string, item *Tag) bool {
+		names = append(names, name)
+		return true
+	})
+	if !reflect.DeepEqual(names, []string{"alpha", "bravo", "delta", "echo"}) {
+		t.Fatalf("unexpected order: %v", names)
+	}
+	if tag := project.Tag("echo"); tag == nil || tag.UserId != 0 {
+		t.Fatalf("unexpected tag: %v", tag)
+	}
+	if items := user.TagsByUser(); len(items) != 3 {
+		t.Fatalf("unexpected tags of user: %v", items)
+	}
+	// state is restored from storages
+	project = NewData(users, tags)
+	if next, _ := project.InsertUser(&User{Name: "next"}); next.Id != user.Id+1 {
+		t.Fatalf("sequence is not restored: %v", next.Id)
+	}
+	if items := project.UserByName("user"); len(items) != 1 {
+		t.Fatalf("index is not restored: %v", items)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	project := DefaultData()
+	for i := 0; i < 100; i++ {
+		project.InsertUser(&User{Name: "user"})
+	}
+	for i := int64(1); i <= 100; i += 2 {
+		project.RemoveUser(i)
+	}
+	if items := project.UserByName("user"); len(items) != 50 {
+		t.Fatalf("unexpected number of users: %v", len(items))
+	}
+	if project.User(1) != nil || project.User(2) == nil {
+		t.Fatal("unexpected state")
+	}
+}
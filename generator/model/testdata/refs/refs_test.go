@@ -0,0 +1,85 @@
+package refs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMissingReference(t *testing.T) {
+	project := DefaultData()
+	user, _ := project.InsertUser(&User{Name: "user"})
+	var missing *ErrMissingReference
+	if _, err := project.InsertTransfer(&Transfer{FromId: user.Id, ToId: user.Id + 1}); !errors.As(err, &missing) || missing.Model != "Transfer" || missing.Field != "To" {
+		t.Fatalf("expected missing reference, got %v", err)
+	}
+	if _, err := project.InsertGroup(&Group{MembersId: []int64{user.Id, user.Id + 1}}); !errors.As(err, &missing) || missing.Field != "Members" {
+		t.Fatalf("expected missing reference, got %v", err)
+	}
+	// zero key is an empty reference, ignored links are not checked
+	if _, err := project.InsertTransfer(&Transfer{FromId: user.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := project.InsertNote(&Note{AuthorId: user.Id + 1}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOnDelete(t *testing.T) {
+	project := DefaultData()
+	alice, _ := project.InsertUser(&User{Name: "alice"})
+	bob, _ := project.InsertUser(&User{Name: "bob"})
+	group, _ := project.InsertGroup(&Group{Name: "all", MembersId: []int64{alice.Id, bob.Id}})
+	transfer, err := project.InsertTransfer(&Transfer{FromId: alice.Id, ToId: bob.Id, Amount: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	note, _ := project.InsertNote(&Note{AuthorId: alice.Id, TransferId: transfer.Id})
+
+	// restrict
+	var restrict *ErrRestrictViolation
+	if err := project.RemoveUser(alice.Id); !errors.As(err, &restrict) || restrict.Model != "Transfer" || restrict.Field != "From" {
+		t.Fatalf("expected restrict violation, got %v", err)
+	}
+	if project.User(alice.Id) == nil || project.Group(group.Id).Members()[0] == nil {
+		t.Fatal("restricted removal changed state")
+	}
+	// cascade (transitive) and set null
+	if err := project.RemoveUser(bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	if project.Transfer(transfer.Id) != nil || project.Note(note.Id) != nil {
+		t.Fatal("cascade removal failed")
+	}
+	if ids := project.Group(group.Id).MembersId; len(ids) != 1 || ids[0] != alice.Id {
+		t.Fatalf("set null failed: %v", ids)
+	}
+	// ignore
+	note, _ = project.InsertNote(&Note{AuthorId: alice.Id})
+	if err := project.RemoveUser(alice.Id); err != nil {
+		t.Fatal(err)
+	}
+	if project.Note(note.Id).Author() != nil || len(project.Group(group.Id).MembersId) != 0 {
+		t.Fatal("unexpected state after removal")
+	}
+}
+
+func TestBackReferences(t *testing.T) {
+	project := DefaultData()
+	alice, _ := project.InsertUser(&User{Name: "alice"})
+	bob, _ := project.InsertUser(&User{Name: "bob"})
+	project.InsertTransfer(&Transfer{FromId: alice.Id, ToId: bob.Id})
+	project.InsertTransfer(&Transfer{FromId: alice.Id, ToId: alice.Id})
+	project.InsertGroup(&Group{MembersId: []int64{alice.Id, bob.Id}})
+	if items := alice.TransfersByFrom(); len(items) != 2 {
+		t.Fatalf("unexpected transfers from alice: %v", items)
+	}
+	if items := bob.TransfersByTo(); len(items) != 1 || items[0].FromId != alice.Id {
+		t.Fatalf("unexpected transfers to bob: %v", items)
+	}
+	if items := bob.GroupsByMembers(); len(items) != 1 {
+		t.Fatalf("unexpected groups of bob: %v", items)
+	}
+	if items := project.TransferByFrom(bob.Id); len(items) != 0 {
+		t.Fatalf("unexpected transfers from bob: %v", items)
+	}
+}
@@ -0,0 +1,85 @@
+package refs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMissingReference(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	user, _ := tx.InsertUser(&User{Name: "user"})
+	// pending item could be referenced
+	if _, err := tx.InsertTransfer(&Transfer{FromId: user.Id, ToId: user.Id}); err != nil {
+		t.Fatal(err)
+	}
+	var missing *ErrMissingReference
+	if _, err := tx.InsertTransfer(&Transfer{FromId: user.Id, ToId: user.Id + 1}); !errors.As(err, &missing) || missing.Model != "Transfer" || missing.Field != "To" {
+		t.Fatalf("expected missing reference, got %v", err)
+	}
+	// pending removal is visible
+	other, _ := tx.InsertUser(&User{Name: "other"})
+	if err := tx.RemoveUser(other.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.InsertGroup(&Group{MembersId: []int64{user.Id, other.Id}}); !errors.As(err, &missing) || missing.Field != "Members" {
+		t.Fatalf("expected missing reference, got %v", err)
+	}
+}
+
+func TestOnDelete(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	group, _ := tx.InsertGroup(&Group{Name: "all", MembersId: []int64{alice.Id, bob.Id}})
+	transfer, err := tx.InsertTransfer(&Transfer{FromId: alice.Id, ToId: bob.Id, Amount: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	note, _ := tx.InsertNote(&Note{AuthorId: alice.Id, TransferId: transfer.Id})
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	var restrict *ErrRestrictViolation
+	if err := tx.RemoveUser(alice.Id); !errors.As(err, &restrict) || restrict.Model != "Transfer" || restrict.Field != "From" {
+		t.Fatalf("expected restrict violation, got %v", err)
+	}
+	if err := tx.RemoveUser(bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	// restriction is released by pending cascade removal
+	if err := tx.RemoveUser(alice.Id); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if view.User(alice.Id) != nil || view.Transfer(transfer.Id) != nil || view.Note(note.Id) != nil {
+		t.Fatal("cascade removal failed")
+	}
+	if ids := view.Group(group.Id).MembersId; len(ids) != 0 {
+		t.Fatalf("set null failed: %v", ids)
+	}
+}
+
+func TestBackReferences(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	tx.InsertTransfer(&Transfer{FromId: alice.Id, ToId: bob.Id})
+	tx.InsertGroup(&Group{MembersId: []int64{alice.Id, bob.Id}})
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if items := view.TransferByFrom(alice.Id); len(items) != 1 || items[0].ToId != bob.Id {
+		t.Fatalf("unexpected transfers from alice: %v", items)
+	}
+	if items := view.User(bob.Id).GroupsByMembers(); len(items) != 1 {
+		t.Fatalf("unexpected groups of bob: %v", items)
+	}
+}
@@ -0,0 +1,99 @@
+package savepoint
+
+import (
+	"testing"
+)
+
+func TestRollbackTo(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	post, _ := tx.InsertPost(&Post{AuthorId: alice.Id})
+	savepoint := tx.Savepoint()
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	if err := tx.RemoveUser(alice.Id); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Post(post.Id) != nil {
+		t.Fatal("post should be removed by cascade")
+	}
+	tx.RollbackTo(savepoint)
+	if tx.User(bob.Id) != nil || len(tx.UserByName("bob")) != 0 {
+		t.Fatal("changes after savepoint are visible")
+	}
+	if tx.User(alice.Id) == nil || tx.Post(post.Id) == nil || len(tx.UserByName("alice")) != 1 {
+		t.Fatal("changes before savepoint are lost")
+	}
+	// sequence increments are undone
+	if carol, _ := tx.InsertUser(&User{Name: "carol"}); carol.Id != bob.Id {
+		t.Fatalf("sequence is not restored: %d != %d", carol.Id, bob.Id)
+	}
+	// savepoint could be used again
+	tx.RollbackTo(savepoint)
+	if len(tx.UserByName("carol")) != 0 {
+		t.Fatal("changes after savepoint are visible")
+	}
+}
+
+func TestNested(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+
+	nested := tx.Begin()
+	bob, _ := nested.InsertUser(&User{Name: "bob"})
+	discarded := nested.Begin()
+	_, _ = discarded.InsertUser(&User{Name: "carol"})
+	if err := discarded.RemoveUser(alice.Id); err != nil {
+		t.Fatal(err)
+	}
+	discarded.Discard()
+	nested.Commit()
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if view.User(alice.Id) == nil || view.User(bob.Id) == nil {
+		t.Fatal("changes of nested transaction are lost")
+	}
+	if len(view.UserByName("carol")) != 0 {
+		t.Fatal("changes of discarded transaction are committed")
+	}
+}
+
+func TestDeferredDiscard(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	func() {
+		nested := tx.Begin()
+		defer nested.Discard()
+		if _, err := nested.InsertUser(&User{Name: "bob"}); err != nil {
+			t.Fatal(err)
+		}
+		nested.Commit()
+	}()
+	// deferred discard of committed nested transaction keeps its changes
+	savepoint := tx.Savepoint()
+	if len(tx.UserByName("bob")) != 1 {
+		t.Fatal("changes of committed nested transaction are discarded")
+	}
+	func() {
+		nested := tx.Begin()
+		defer nested.Discard()
+		if err := nested.RemoveUser(alice.Id); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if tx.User(alice.Id) == nil {
+		t.Fatal("changes of discarded nested transaction are kept")
+	}
+	// unknown savepoints are ignored
+	tx.RollbackTo(-1)
+	tx.RollbackTo(savepoint + 10)
+	if len(tx.UserByName("bob")) != 1 {
+		t.Fatal("unknown savepoint rolled back changes")
+	}
+}
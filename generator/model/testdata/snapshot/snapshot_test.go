@@ -0,0 +1,76 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	project := DefaultData()
+	alice, _ := project.InsertUser(&User{Name: "alice", Tags: []string{"admin"}})
+	bob, _ := project.InsertUser(&User{Name: "bob"})
+	if _, err := project.InsertTransfer(&Transfer{FromId: alice.Id, Amount: 10}); err != nil {
+		t.Fatal(err)
+	}
+	removed, _ := project.InsertUser(&User{Name: "removed"})
+	project.RemoveUser(removed.Id)
+
+	var buffer bytes.Buffer
+	if err := project.Save(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := DefaultData()
+	if _, err := restored.InsertUser(&User{Name: "stale"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.Load(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	if user := restored.User(bob.Id); user == nil || user.Name != "bob" {
+		t.Fatalf("user not restored: %v", user)
+	}
+	if user := restored.User(alice.Id); user == nil || len(user.Tags) != 1 || user.Tags[0] != "admin" {
+		t.Fatalf("user not restored: %v", user)
+	}
+	if users := restored.UserByName("stale"); len(users) != 0 {
+		t.Fatalf("stale items should be replaced, got %v", users)
+	}
+	if transfers := restored.TransferByFrom(alice.Id); len(transfers) != 1 || transfers[0].Amount != 10 {
+		t.Fatalf("transfer not restored: %v", transfers)
+	}
+	// sequence continues after removed item
+	next, _ := restored.InsertUser(&User{Name: "next"})
+	if next.Id != removed.Id+1 {
+		t.Fatalf("sequence not restored: %d", next.Id)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	project := DefaultData()
+	if err := project.Load(bytes.NewBufferString("garbage snapshot")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLoadOtherSchema(t *testing.T) {
+	project := DefaultData()
+	if _, err := project.InsertUser(&User{Name: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	if err := project.Save(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	// snapshot of the same project with other fields
+	data := buffer.Bytes()
+	data[len(dataSnapshotMagic)+4] ^= 0xff
+
+	restored := DefaultData()
+	if err := restored.Load(bytes.NewReader(data)); err == nil {
+		t.Fatal("expected error")
+	}
+	if user := restored.User(1); user != nil {
+		t.Fatalf("rejected snapshot should not be loaded: %v", user)
+	}
+}
@@ -0,0 +1,62 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	if _, err := tx.InsertTransfer(&Transfer{FromId: alice.Id, Amount: 10}); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+
+	var buffer bytes.Buffer
+	if err := project.Save(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	restored := DefaultData()
+	if err := restored.Load(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	view := restored.ReadLock()
+	if user := view.User(alice.Id); user == nil || user.Name != "alice" {
+		t.Fatalf("user not restored: %v", user)
+	}
+	if transfers := view.TransferByFrom(alice.Id); len(transfers) != 1 {
+		t.Fatalf("transfer not restored: %v", transfers)
+	}
+	view.ReadUnlock()
+}
+
+func TestStorageSnapshot(t *testing.T) {
+	storage := NewMapDataStorage()
+	project := NewData(storage)
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	tx.Commit()
+
+	var buffer bytes.Buffer
+	if err := SaveDataStorage(storage, &buffer); err != nil {
+		t.Fatal(err)
+	}
+	restoredStorage := NewMapDataStorage()
+	if err := LoadDataStorage(restoredStorage, &buffer); err != nil {
+		t.Fatal(err)
+	}
+	if user := restoredStorage.GetUser(alice.Id); user == nil || user.Name != "alice" {
+		t.Fatalf("user not restored: %v", user)
+	}
+	// sequences are restored from items by constructor
+	restored := NewData(restoredStorage)
+	tx = restored.ReadWriteLock()
+	next, _ := tx.InsertUser(&User{Name: "next"})
+	tx.Discard()
+	if next.Id != bob.Id+1 {
+		t.Fatalf("sequence not restored: %d", next.Id)
+	}
+}
@@ -0,0 +1,20 @@
+CREATE TABLE "user" (
+  "id" BIGINT NOT NULL PRIMARY KEY,
+  "age" INTEGER NOT NULL,
+  "joined" TIMESTAMP NOT NULL,
+  "name" TEXT NOT NULL,
+  "tags" TEXT NOT NULL
+);
+
+CREATE TABLE "user_friends" (
+  "owner" BIGINT NOT NULL,
+  "position" INTEGER NOT NULL,
+  "target" BIGINT NOT NULL,
+  PRIMARY KEY ("owner", "position")
+);
+
+CREATE TABLE "transfer" (
+  "id" BIGINT NOT NULL PRIMARY KEY,
+  "amount" DOUBLE PRECISION NOT NULL,
+  "from_id" BIGINT NOT NULL
+);
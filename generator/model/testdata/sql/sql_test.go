@@ -0,0 +1,101 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "example.com/sqlstore/fakesql"
+)
+
+func open(t *testing.T) *sql.DB {
+	db, err := sql.Open("fakesql", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestSQLStorage(t *testing.T) {
+	db := open(t)
+	defer db.Close()
+	if err := CreateDataSQLSchema(db); err != nil {
+		t.Fatal(err)
+	}
+	project := NewData(NewSQLUserStorage(db), NewSQLTransferStorage(db))
+	joined := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	alice, err := project.InsertUser(&User{Name: "alice", Age: 30, Tags: []string{"admin"}, Joined: joined})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, _ := project.InsertUser(&User{Name: "bob", FriendsId: []int64{alice.Id}})
+	carol, _ := project.InsertUser(&User{Name: "carol"})
+	if _, err := project.InsertTransfer(&Transfer{FromId: bob.Id, Amount: 1.5}); err != nil {
+		t.Fatal(err)
+	}
+	changed := *bob
+	changed.FriendsId = []int64{carol.Id, alice.Id}
+	if _, err := project.UpdateUser(&changed); err != nil {
+		t.Fatal(err)
+	}
+	if err := project.RemoveUser(carol.Id); err != nil {
+		t.Fatal(err)
+	}
+
+	// state is read from database by other project
+	restored := NewData(NewSQLUserStorage(db), NewSQLTransferStorage(db))
+	user := restored.User(alice.Id)
+	if user == nil || user.Name != "alice" || user.Age != 30 || len(user.Tags) != 1 || user.Tags[0] != "admin" || !user.Joined.Equal(joined) {
+		t.Fatalf("user not restored: %+v", user)
+	}
+	if restored.User(carol.Id) != nil {
+		t.Fatal("removed user restored")
+	}
+	user = restored.User(bob.Id)
+	if friends := user.Friends(); len(friends) != 1 || friends[0].Name != "alice" {
+		t.Fatalf("links not restored: %+v", user)
+	}
+	if users := restored.UserByName("bob"); len(users) != 1 {
+		t.Fatalf("index not restored: %v", users)
+	}
+	transfers := restored.TransferByFrom(bob.Id)
+	if len(transfers) != 1 || transfers[0].Amount != 1.5 || transfers[0].From().Name != "bob" {
+		t.Fatalf("transfer not restored: %v", transfers)
+	}
+	next, _ := restored.InsertUser(&User{Name: "next"})
+	if next.Id != bob.Id+1 {
+		t.Fatalf("sequence not restored: %d", next.Id)
+	}
+	// cascade removal is written to database
+	if err := restored.RemoveUser(bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	NewSQLTransferStorage(db).IterateTransfer(func(id int64, item *Transfer) bool {
+		count++
+		return true
+	})
+	if count != 0 {
+		t.Fatalf("transfers of removed user are kept: %d", count)
+	}
+}
+
+func TestFailedWrite(t *testing.T) {
+	db := open(t)
+	defer db.Close()
+	if err := CreateDataSQLSchema(db); err != nil {
+		t.Fatal(err)
+	}
+	storage := NewSQLUserStorage(db)
+	storage.PutUser(1, &User{Id: 1, Name: "alice"})
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+		// links of failed write are rolled back
+		if user := storage.GetUser(1); user == nil || user.Name != "alice" || len(user.FriendsId) != 0 {
+			t.Fatalf("failed write is not rolled back: %+v", user)
+		}
+	}()
+	storage.PutUser(1, &User{Id: 1, Name: "duplicate", FriendsId: []int64{1}})
+}
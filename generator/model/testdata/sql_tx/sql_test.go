@@ -0,0 +1,76 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "example.com/sqlstore/fakesql"
+)
+
+func TestSQLTxStorage(t *testing.T) {
+	db, err := sql.Open("fakesql", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := CreateDataSQLSchema(db); err != nil {
+		t.Fatal(err)
+	}
+	project := NewData(NewSQLDataStorage(db))
+	tx := project.ReadWriteLock()
+	_, _ = tx.InsertAccount(&Account{Name: "alice", Balance: 10, Labels: map[string]string{"tier": "gold"}})
+	_, _ = tx.InsertAccount(&Account{Name: "bob"})
+	group, _ := tx.InsertGroup(&Group{Title: "team", MembersName: []string{"bob", "alice"}})
+	tx.Commit()
+	// discarded changes are not written
+	tx = project.ReadWriteLock()
+	_ = tx.RemoveAccount("bob")
+	tx.Discard()
+
+	restored := NewData(NewSQLDataStorage(db))
+	view := restored.ReadLock()
+	defer view.ReadUnlock()
+	if account := view.Account("alice"); account == nil || account.Balance != 10 || account.Labels["tier"] != "gold" {
+		t.Fatalf("account not restored: %+v", account)
+	}
+	restoredGroup := view.Group(group.Id)
+	if restoredGroup == nil || restoredGroup.Title != "team" {
+		t.Fatalf("group not restored: %+v", restoredGroup)
+	}
+	if members := restoredGroup.Members(); len(members) != 2 || members[0].Name != "bob" || members[1].Name != "alice" {
+		t.Fatalf("members not restored in order: %v", members)
+	}
+	var names []string
+	view.AccountRange("a", "z", func(item *Account) bool {
+		names = append(names, item.Name)
+		return true
+	})
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Fatalf("unexpected range %v", names)
+	}
+}
+
+func TestApplyInTransaction(t *testing.T) {
+	db, err := sql.Open("fakesql", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := CreateDataSQLSchema(db); err != nil {
+		t.Fatal(err)
+	}
+	storage := NewSQLDataStorage(db)
+	storage.Apply([]DataLogEntity{{Account: &AccountLogEntity{Name: "alice", Item: Account{Name: "alice"}, Action: DataActionInsert}}})
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+		if storage.GetAccount("bob") != nil {
+			t.Fatal("changes of failed batch are not rolled back")
+		}
+	}()
+	storage.Apply([]DataLogEntity{
+		{Account: &AccountLogEntity{Name: "bob", Item: Account{Name: "bob"}, Action: DataActionInsert}},
+		{Account: &AccountLogEntity{Name: "alice", Item: Account{Name: "alice"}, Action: DataActionInsert}},
+	})
+}
@@ -0,0 +1,62 @@
+package unique
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUniqueViolation(t *testing.T) {
+	project := DefaultData()
+	first, err := project.InsertUser(&User{Name: "first", Email: "user@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = project.InsertUser(&User{Name: "second", Email: "user@example.com"})
+	var violation *ErrUniqueViolation
+	if !errors.As(err, &violation) || violation.Model != "User" || violation.Field != "Email" {
+		t.Fatalf("expected unique violation, got %v", err)
+	}
+	second, err := project.InsertUser(&User{Name: "second", Email: "second@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// same item could be updated with same value
+	if _, err = project.UpdateUser(first); err != nil {
+		t.Fatal(err)
+	}
+	second.Email = "user@example.com"
+	if _, err = project.UpdateUser(second); !errors.As(err, &violation) {
+		t.Fatalf("expected unique violation, got %v", err)
+	}
+	project.RemoveUser(first.Id)
+	if _, err = project.UpdateUser(second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRejectedInsert(t *testing.T) {
+	project := DefaultData()
+	first, err := project.InsertUser(&User{Name: "first", Email: "user@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rejected := &User{Name: "rejected", Email: "user@example.com"}
+	if _, err = project.InsertUser(rejected); err == nil {
+		t.Fatal("expected unique violation")
+	}
+	if rejected.Id != 0 || rejected.Name != "rejected" {
+		t.Fatalf("rejected item is changed: %+v", rejected)
+	}
+	// key of caller is replaced by sequence and doesn't hide conflict with stored item
+	if _, err = project.InsertUser(&User{Id: first.Id, Name: "same key", Email: "user@example.com"}); err == nil {
+		t.Fatal("expected unique violation")
+	}
+	rejected.Email = "second@example.com"
+	second, err := project.InsertUser(rejected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second != rejected || second.Id != first.Id+1 {
+		t.Fatalf("rejected inserts consumed sequence: %+v", second)
+	}
+}
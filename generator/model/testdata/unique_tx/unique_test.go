@@ -0,0 +1,48 @@
+package unique
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUniqueViolation(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	first, err := tx.InsertUser(&User{Name: "first", Email: "user@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// violation against pending log
+	_, err = tx.InsertUser(&User{Name: "second", Email: "user@example.com"})
+	var violation *ErrUniqueViolation
+	if !errors.As(err, &violation) || violation.Model != "User" || violation.Field != "Email" {
+		t.Fatalf("expected unique violation, got %v", err)
+	}
+	tx.Commit()
+
+	// violation against committed storage
+	tx = project.ReadWriteLock()
+	if _, err = tx.InsertUser(&User{Name: "second", Email: "user@example.com"}); !errors.As(err, &violation) {
+		t.Fatalf("expected unique violation, got %v", err)
+	}
+	// value released in the same transaction
+	changed := *first
+	changed.Email = "first@example.com"
+	if _, err = tx.UpdateUser(&changed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.InsertUser(&User{Name: "second", Email: "user@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	tx.RemoveUser(changed.Id)
+	if _, err = tx.InsertUser(&User{Name: "third", Email: "first@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+
+	view := project.ReadLock()
+	defer view.ReadUnlock()
+	if items := view.UserByEmail("user@example.com"); len(items) != 1 || items[0].Name != "second" {
+		t.Fatalf("unexpected items: %v", items)
+	}
+}
@@ -0,0 +1,72 @@
+package validate
+
+import (
+	"testing"
+)
+
+func valid() *User {
+	return &User{Name: "anna", Email: "anna@example", Role: "admin", Age: 21, Score: 0.5, Tags: []string{"a"}, TeamId: 1, Code: "ЯЯЯ"}
+}
+
+func TestValidate(t *testing.T) {
+	if err := valid().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]func(user *User){
+		"Name: required":   func(user *User) { user.Name = "" },
+		"Name: min":        func(user *User) { user.Name = "a" },
+		"Name: max":        func(user *User) { user.Name = "abcdef" },
+		"Email: pattern":   func(user *User) { user.Email = "anna" },
+		"Role: oneof":      func(user *User) { user.Role = "root" },
+		"Age: min":         func(user *User) { user.Age = 17 },
+		"Age: oneof":       func(user *User) { user.Age = 22 },
+		"Score: max":       func(user *User) { user.Score = 0.6 },
+		"Tags: max":        func(user *User) { user.Tags = []string{"a", "b", "c"} },
+		"TeamId: required": func(user *User) { user.TeamId = 0 },
+		"Code: len":        func(user *User) { user.Code = "ab" },
+	}
+	for expected, change := range cases {
+		user := valid()
+		change(user)
+		err := user.Validate()
+		if err == nil {
+			t.Errorf("%s: no error", expected)
+			continue
+		}
+		if err.Error() != "invalid User."+expected {
+			t.Errorf("%s: unexpected error %v", expected, err)
+		}
+	}
+}
+
+func TestWriteInvalid(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	defer tx.Discard()
+	team, _ := tx.InsertTeam(&Team{Name: "team"})
+	user := valid()
+	user.TeamId = team.Id
+	user.Name = ""
+	if _, err := tx.InsertUser(user); err == nil {
+		t.Fatal("invalid item is inserted")
+	}
+	if len(tx.UserByTeam(team.Id)) != 0 {
+		t.Fatal("invalid item is logged")
+	}
+	if user.Id != 0 || user.Name != "" {
+		t.Fatalf("invalid item is changed: %+v", user)
+	}
+	user.Name = "anna"
+	if _, err := tx.InsertUser(user); err != nil {
+		t.Fatal(err)
+	}
+	changed := *user
+	changed.Role = "root"
+	_, err := tx.UpdateUser(&changed)
+	if invalid, ok := err.(*ErrValidation); !ok || invalid.Field != "Role" || invalid.Rule != "oneof" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if tx.User(user.Id).Role != "admin" {
+		t.Fatal("invalid item is updated")
+	}
+}
@@ -0,0 +1,62 @@
+package version
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	project := DefaultData()
+	account, _ := project.InsertAccount(&Account{Balance: 10})
+	if account.Version != 1 {
+		t.Fatalf("inserted version %d", account.Version)
+	}
+	first := *account
+	second := *account
+	first.Balance = 20
+	if _, err := project.UpdateAccount(&first); err != nil {
+		t.Fatal(err)
+	}
+	if first.Version != 2 || project.Account(account.Id).Version != 2 {
+		t.Fatal("version is not bumped")
+	}
+	second.Balance = 30
+	_, err := project.UpdateAccount(&second)
+	if conflict, ok := err.(*ErrConflict); !ok || conflict.Model != "Account" {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if project.Account(account.Id).Balance != 20 {
+		t.Fatal("conflicted update is applied")
+	}
+	// set null changes referrer
+	transfer, _ := project.InsertTransfer(&Transfer{FromId: account.Id})
+	if err := project.RemoveAccount(account.Id); err != nil {
+		t.Fatal(err)
+	}
+	if changed := project.Transfer(transfer.Id); changed.FromId != 0 || changed.Version != 2 {
+		t.Fatalf("unexpected referrer %+v", changed)
+	}
+}
+
+func TestVersionNoLostUpdates(t *testing.T) {
+	project := DefaultData()
+	account, _ := project.InsertAccount(&Account{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for done := 0; done < 100; {
+				item := *project.Account(account.Id)
+				item.Balance++
+				if _, err := project.UpdateAccount(&item); err == nil {
+					done++
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if balance := project.Account(account.Id).Balance; balance != 800 {
+		t.Fatalf("lost updates: %d", balance)
+	}
+}
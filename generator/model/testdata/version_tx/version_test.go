@@ -0,0 +1,35 @@
+package version
+
+import (
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	project := DefaultData()
+	tx := project.ReadWriteLock()
+	account, _ := tx.InsertAccount(&Account{Balance: 10})
+	tx.Commit()
+
+	stale := *account
+	tx = project.ReadWriteLock()
+	changed := *account
+	changed.Balance = 20
+	if _, err := tx.UpdateAccount(&changed); err != nil {
+		t.Fatal(err)
+	}
+	// pending version is checked
+	again := *account
+	if _, err := tx.UpdateAccount(&again); err == nil {
+		t.Fatal("expected conflict with pending change")
+	}
+	tx.Commit()
+
+	tx = project.ReadWriteLock()
+	defer tx.Discard()
+	if _, err := tx.UpdateAccount(&stale); err == nil {
+		t.Fatal("expected conflict with committed change")
+	}
+	if item := tx.Account(account.Id); item.Version != 2 || item.Balance != 20 {
+		t.Fatalf("unexpected item %+v", item)
+	}
+}
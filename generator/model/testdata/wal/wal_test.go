@@ -0,0 +1,164 @@
+package wal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func open(t *testing.T, path string) (Data, *DataWAL) {
+	wal, err := OpenDataWAL(path, DataSyncEachCommit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	project, err := NewData(NewMapDataStorage(), wal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return project, wal
+}
+
+func TestReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.wal")
+	project, wal := open(t, path)
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	tx = project.ReadWriteLock()
+	if err := tx.RemoveUser(bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	// discarded changes are not logged
+	tx = project.ReadWriteLock()
+	_, _ = tx.InsertUser(&User{Name: "discarded"})
+	tx.Discard()
+	if err := wal.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, wal := open(t, path)
+	defer wal.Close()
+	view := restored.ReadLock()
+	if user := view.User(alice.Id); user == nil || user.Name != "alice" {
+		t.Fatalf("user not replayed: %v", user)
+	}
+	if view.User(bob.Id) != nil {
+		t.Fatal("removed user replayed")
+	}
+	if users := view.UserByName("alice"); len(users) != 1 {
+		t.Fatalf("index not restored: %v", users)
+	}
+	view.ReadUnlock()
+}
+
+func TestCompactAndTornTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.wal")
+	project, wal := open(t, path)
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	_ = tx.Commit()
+	if err := project.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != 0 {
+		t.Fatalf("log not truncated: %v %v", info, err)
+	}
+	tx = project.ReadWriteLock()
+	bob, _ := tx.InsertUser(&User{Name: "bob"})
+	_ = tx.Commit()
+	_ = wal.Close()
+	// simulate interrupted write of the next record
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = file.Write([]byte{0, 0, 1, 0, 1, 2})
+	_ = file.Close()
+
+	restored, wal := open(t, path)
+	defer wal.Close()
+	tx = restored.ReadWriteLock()
+	if tx.User(alice.Id) == nil || tx.User(bob.Id) == nil {
+		t.Fatal("users not restored from snapshot and log")
+	}
+	next, _ := tx.InsertUser(&User{Name: "next"})
+	if next.Id != bob.Id+1 {
+		t.Fatalf("sequence not restored: %d", next.Id)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHugeLengthOfTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.wal")
+	project, wal := open(t, path)
+	tx := project.ReadWriteLock()
+	alice, _ := tx.InsertUser(&User{Name: "alice"})
+	_ = tx.Commit()
+	_ = wal.Close()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// header of interrupted record with length far beyond end of file
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = file.Write([]byte{0xff, 0xff, 0xff, 0xff, 1, 2, 3, 4, 5})
+	_ = file.Close()
+
+	restored, wal := open(t, path)
+	defer wal.Close()
+	view := restored.ReadLock()
+	defer view.ReadUnlock()
+	if view.User(alice.Id) == nil {
+		t.Fatal("user not replayed")
+	}
+	if truncated, err := os.Stat(path); err != nil || truncated.Size() != info.Size() {
+		t.Fatalf("torn tail not cut off: %v %v", truncated, err)
+	}
+}
+
+func TestCorruptedMiddle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.wal")
+	project, wal := open(t, path)
+	for _, name := range []string{"alice", "bob"} {
+		tx := project.ReadWriteLock()
+		_, _ = tx.InsertUser(&User{Name: name})
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = wal.Close()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// damage payload of the first record
+	file, err := os.OpenFile(path, os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = file.WriteAt([]byte{0xff}, 10)
+	_ = file.Close()
+
+	wal, err = OpenDataWAL(path, DataSyncEachCommit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wal.Close()
+	if _, err := NewData(NewMapDataStorage(), wal); err == nil {
+		t.Fatal("expected error")
+	}
+	if kept, err := os.Stat(path); err != nil || kept.Size() != info.Size() {
+		t.Fatalf("log should not be truncated: %v %v", kept, err)
+	}
+}
@@ -0,0 +1,234 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// validatedField returns name and type of struct field for field (or link) of the model
+func validatedField(model *memdata.Model, name string) (string, string) {
+	if fieldType, ok := model.Fields[name]; ok {
+		return name, fieldType
+	}
+	if target, ok := model.Ref[name]; ok {
+		targetModel := model.Project.Model(target)
+		return name + targetModel.Indexed, targetModel.FieldType(targetModel.Indexed)
+	}
+	if target, ok := model.HasMany[name]; ok {
+		targetModel := model.Project.Model(target)
+		return name + targetModel.Indexed, "[]" + targetModel.FieldType(targetModel.Indexed)
+	}
+	panic("validation of unknown field " + model.Name + "." + name)
+}
+
+func validatedFields(model *memdata.Model) []string {
+	var names []string
+	for name := range model.Validate {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkValidation checks that rules are applicable to types of fields
+func checkValidation(model *memdata.Model) {
+	for _, name := range validatedFields(model) {
+		rules := model.Validate[name]
+		if rules == nil {
+			continue
+		}
+		_, fieldType := validatedField(model, name)
+		path := model.Name + "." + name
+		isString := fieldType == "string"
+		isSlice := strings.HasPrefix(fieldType, "[]")
+		isNumber := memdata.IsNumType(fieldType)
+		if (rules.Min != nil || rules.Max != nil) && !isString && !isSlice && !isNumber {
+			panic("min and max of " + path + " require number, string or slice field")
+		}
+		if rules.Len != nil && !isString && !isSlice {
+			panic("len of " + path + " requires string or slice field")
+		}
+		if rules.Pattern != "" {
+			if !isString {
+				panic("pattern of " + path + " requires string field")
+			}
+			if _, err := regexp.Compile(rules.Pattern); err != nil {
+				panic("invalid pattern of " + path + ": " + err.Error())
+			}
+		}
+		if len(rules.OneOf) > 0 && !isString && !isNumber {
+			panic("oneof of " + path + " requires number or string field")
+		}
+		if isNumber {
+			lowest, highest := numberRange(fieldType)
+			for _, limit := range []*float64{rules.Min, rules.Max} {
+				if limit != nil && isIntegerType(fieldType) && *limit != math.Trunc(*limit) {
+					panic("min and max of " + path + " should be integer")
+				}
+				if limit != nil && (*limit < lowest || *limit > highest) {
+					panic("min and max of " + path + " are out of range of " + fieldType)
+				}
+			}
+			for _, value := range rules.OneOf {
+				numberLiteral(path, fieldType, value)
+				if number, _ := strconv.ParseFloat(value, 64); number < lowest || number > highest {
+					panic("oneof value " + value + " of " + path + " is out of range of " + fieldType)
+				}
+			}
+		}
+	}
+}
+
+// numberRange returns the lowest and the highest values of number type (int and uint are 64 bits)
+func numberRange(fieldType string) (float64, float64) {
+	switch fieldType {
+	case "int8":
+		return math.MinInt8, math.MaxInt8
+	case "int16":
+		return math.MinInt16, math.MaxInt16
+	case "int32", "rune":
+		return math.MinInt32, math.MaxInt32
+	case "int", "int64":
+		return math.MinInt64, math.MaxInt64
+	case "uint8", "byte":
+		return 0, math.MaxUint8
+	case "uint16":
+		return 0, math.MaxUint16
+	case "uint32":
+		return 0, math.MaxUint32
+	case "uint", "uint64":
+		return 0, math.MaxUint64
+	case "float32":
+		return -math.MaxFloat32, math.MaxFloat32
+	}
+	return -math.MaxFloat64, math.MaxFloat64
+}
+
+// numberLiteral converts value of YAML to literal of number field
+func numberLiteral(path, fieldType string, value string) jen.Code {
+	if isIntegerType(fieldType) {
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			panic("invalid integer value " + value + " of " + path)
+		}
+		return jen.Lit(int(number))
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		panic("invalid number value " + value + " of " + path)
+	}
+	return jen.Lit(number)
+}
+
+func limitLiteral(fieldType string, limit float64) jen.Code {
+	if memdata.IsNumType(fieldType) && !isIntegerType(fieldType) {
+		return jen.Lit(limit)
+	}
+	return jen.Lit(int(limit))
+}
+
+func patternName(model *memdata.Model, field string) string {
+	return memdata.ToLowerCamel(model.Name) + field + "Pattern"
+}
+
+// generateValidationCheck returns an error from the enclosing writer if the item (variable with pointer) is not valid
+func generateValidationCheck(group *jen.Group, model *memdata.Model, item string) {
+	if len(model.Validate) == 0 {
+		return
+	}
+	group.If(jen.Err().Op(":=").Id(item).Dot("Validate").Call(), jen.Err().Op("!=").Nil()).Block(
+		jen.Return(jen.Nil(), jen.Err()),
+	)
+}
+
+// generateValidate defines Validate method of model by validation rules of fields
+func generateValidate(model *memdata.Model) jen.Code {
+	code := jen.Line()
+	if len(model.Validate) == 0 {
+		return code
+	}
+	names := validatedFields(model)
+	for _, name := range names {
+		if rules := model.Validate[name]; rules != nil && rules.Pattern != "" {
+			code.Var().Id(patternName(model, name)).Op("=").Qual("regexp", "MustCompile").Call(jen.Lit(rules.Pattern)).Line()
+		}
+	}
+	code.Comment("Validate checks fields of item by validation rules and returns *ErrValidation of the first invalid field").Line()
+	code.Func().Parens(jen.Id("model").Op("*").Id(model.Name)).Id("Validate").Params().Error().BlockFunc(func(validateFunc *jen.Group) {
+		for _, name := range names {
+			rules := model.Validate[name]
+			if rules == nil {
+				continue
+			}
+			fieldName, fieldType := validatedField(model, name)
+			value := jen.Id("model").Dot(fieldName)
+			invalid := func(rule string) jen.Code {
+				return jen.Return().Op("&").Id("ErrValidation").Values(
+					jen.Id("Model").Op(":").Lit(model.Name),
+					jen.Id("Field").Op(":").Lit(fieldName),
+					jen.Id("Rule").Op(":").Lit(rule),
+				)
+			}
+			isString := fieldType == "string"
+			isSlice := strings.HasPrefix(fieldType, "[]")
+			isNumber := memdata.IsNumType(fieldType)
+			// length of strings (in runes) and slices, value of numbers
+			var measure jen.Code
+			switch {
+			case isString:
+				measure = jen.Qual("unicode/utf8", "RuneCountInString").Call(value.Clone())
+			case isSlice:
+				measure = jen.Len(value.Clone())
+			case isNumber:
+				measure = value.Clone()
+			}
+			if rules.Required {
+				var empty *jen.Statement
+				switch {
+				case isSlice || strings.HasPrefix(fieldType, "map["):
+					empty = jen.Len(value.Clone()).Op("==").Lit(0)
+				case strings.HasPrefix(fieldType, "*"):
+					empty = value.Clone().Op("==").Nil()
+				case fieldType == "bool":
+					empty = jen.Op("!").Add(value.Clone())
+				default:
+					empty = value.Clone().Op("==").Add(zeroValue(fieldType))
+				}
+				validateFunc.If(empty).Block(invalid("required"))
+			}
+			if rules.Len != nil {
+				validateFunc.If(jen.Add(measure).Op("!=").Lit(*rules.Len)).Block(invalid("len"))
+			}
+			if rules.Min != nil {
+				validateFunc.If(jen.Add(measure).Op("<").Add(limitLiteral(fieldType, *rules.Min))).Block(invalid("min"))
+			}
+			if rules.Max != nil {
+				validateFunc.If(jen.Add(measure).Op(">").Add(limitLiteral(fieldType, *rules.Max))).Block(invalid("max"))
+			}
+			if rules.Pattern != "" {
+				validateFunc.If(jen.Op("!").Id(patternName(model, name)).Dot("MatchString").Call(value.Clone())).Block(invalid("pattern"))
+			}
+			if len(rules.OneOf) > 0 {
+				validateFunc.Switch(value.Clone()).BlockFunc(func(cases *jen.Group) {
+					cases.CaseFunc(func(values *jen.Group) {
+						for _, allowed := range rules.OneOf {
+							if isString {
+								values.Lit(allowed)
+							} else {
+								values.Add(numberLiteral(model.Name+"."+name, fieldType, allowed))
+							}
+						}
+					})
+					cases.Default().Block(invalid("oneof"))
+				})
+			}
+		}
+		validateFunc.Return().Nil()
+	}).Line()
+	return code
+}
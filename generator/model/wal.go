@@ -0,0 +1,205 @@
+package model
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/reddec/memdata"
+)
+
+// walHeaderSize is size of record header: payload length and CRC-32 of payload
+const walHeaderSize = 8
+
+// generateWAL defines file-backed write-ahead log of committed batches. Each record is length and checksum of
+// payload followed by gob-encoded batch. Compaction writes snapshot of storage next to the log and truncates the log.
+func generateWAL(proj *memdata.Project) jen.Code {
+	walType := proj.Name + "WAL"
+	policyType := proj.Name + "SyncPolicy"
+	errChecksum := "err" + proj.Name + "WALChecksum"
+	recv := func() *jen.Statement { return jen.Parens(jen.Id("wal").Op("*").Id(walType)) }
+	returnIfErr := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+
+	code := jen.Comment(policyType + " defines when appended records are flushed to disk").Line()
+	code.Type().Id(policyType).Int().Line()
+	code.Const().Defs(
+		jen.Comment("fsync after each commit (default)"),
+		jen.Id(proj.Name+"SyncEachCommit").Id(policyType).Op("=").Lit(0),
+		jen.Comment("flush is up to OS or explicit call of Sync"),
+		jen.Id(proj.Name+"SyncNone").Id(policyType).Op("=").Lit(1),
+	).Line()
+	code.Var().Id(errChecksum).Op("=").Qual("errors", "New").Call(jen.Lit("checksum mismatch of WAL record")).Line()
+	code.Comment(walType + " is file-backed write-ahead log of committed transactions").Line()
+	code.Type().Id(walType).Struct(
+		jen.Id("path").String(),
+		jen.Id("policy").Id(policyType),
+		jen.Id("file").Op("*").Qual("os", "File"),
+		jen.Id("size").Int64(),
+		jen.Id("broken").Error().Comment("failed cut off of records: log is not used till reopen"),
+	).Line()
+
+	code.Comment("Open" + walType + " opens (or creates) log file. Snapshot of compacted log is stored in the same directory with .snapshot suffix").Line()
+	code.Func().Id("Open"+walType).Params(jen.Id("path").String(), jen.Id("policy").Id(policyType)).Params(jen.Op("*").Id(walType), jen.Error()).BlockFunc(func(openFunc *jen.Group) {
+		openFunc.List(jen.Id("file"), jen.Err()).Op(":=").Qual("os", "OpenFile").Call(jen.Id("path"), jen.Qual("os", "O_CREATE").Op("|").Qual("os", "O_RDWR"), jen.Lit(0644))
+		openFunc.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+		openFunc.List(jen.Id("info"), jen.Err()).Op(":=").Id("file").Dot("Stat").Call()
+		openFunc.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("_").Op("=").Id("file").Dot("Close").Call(),
+			jen.Return(jen.Nil(), jen.Err()),
+		)
+		openFunc.Return(jen.Op("&").Id(walType).Values(
+			jen.Id("path").Op(":").Id("path"),
+			jen.Id("policy").Op(":").Id("policy"),
+			jen.Id("file").Op(":").Id("file"),
+			jen.Id("size").Op(":").Id("info").Dot("Size").Call(),
+		), jen.Nil())
+	}).Line()
+
+	code.Comment("Sync flushes appended records to disk").Line()
+	code.Func().Add(recv()).Id("Sync").Params().Error().Block(
+		jen.Return().Id("wal").Dot("file").Dot("Sync").Call(),
+	).Line()
+	code.Comment("Close log file").Line()
+	code.Func().Add(recv()).Id("Close").Params().Error().Block(
+		jen.Return().Id("wal").Dot("file").Dot("Close").Call(),
+	).Line()
+
+	// cut off records after the size: log is broken if it fails. Error of cut off is returned with error of cause
+	cutOff := func(size jen.Code, cause ...jen.Code) jen.Code {
+		result := jen.Id("cutErr")
+		if len(cause) > 0 {
+			result = jen.Qual("errors", "Join").Call(append(cause, jen.Id("cutErr"))...)
+		}
+		return jen.If(jen.Id("cutErr").Op(":=").Id("wal").Dot("file").Dot("Truncate").Call(size), jen.Id("cutErr").Op("!=").Nil()).Block(
+			jen.Id("wal").Dot("broken").Op("=").Id("cutErr"),
+			jen.Return(result),
+		)
+	}
+	// append record, partially written record is cut off
+	code.Func().Add(recv()).Id("append").Params(jen.Id("batch").Index().Id(proj.Name + "LogEntity")).Error().BlockFunc(func(appendFunc *jen.Group) {
+		appendFunc.If(jen.Id("wal").Dot("broken").Op("!=").Nil()).Block(
+			jen.Return().Qual("fmt", "Errorf").Call(jen.Lit("WAL is broken by failed cut off of record: %w"), jen.Id("wal").Dot("broken")),
+		)
+		appendFunc.Var().Id("payload").Qual("bytes", "Buffer")
+		appendFunc.If(jen.Err().Op(":=").Qual("encoding/gob", "NewEncoder").Call(jen.Op("&").Id("payload")).Dot("Encode").Call(jen.Id("batch")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		appendFunc.Id("record").Op(":=").Make(jen.Index().Byte(), jen.Lit(walHeaderSize), jen.Lit(walHeaderSize).Op("+").Id("payload").Dot("Len").Call())
+		appendFunc.Qual("encoding/binary", "BigEndian").Dot("PutUint32").Call(jen.Id("record").Index(jen.Lit(0), jen.Lit(4)), jen.Uint32().Call(jen.Id("payload").Dot("Len").Call()))
+		appendFunc.Qual("encoding/binary", "BigEndian").Dot("PutUint32").Call(jen.Id("record").Index(jen.Lit(4), jen.Lit(walHeaderSize)), jen.Qual("hash/crc32", "ChecksumIEEE").Call(jen.Id("payload").Dot("Bytes").Call()))
+		appendFunc.Id("record").Op("=").Append(jen.Id("record"), jen.Id("payload").Dot("Bytes").Call().Op("..."))
+		appendFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("wal").Dot("file").Dot("WriteAt").Call(jen.Id("record"), jen.Id("wal").Dot("size")), jen.Err().Op("!=").Nil()).Block(
+			cutOff(jen.Id("wal").Dot("size"), jen.Err()),
+			jen.Return().Err(),
+		)
+		appendFunc.If(jen.Id("wal").Dot("policy").Op("==").Id(proj.Name + "SyncEachCommit")).Block(
+			jen.If(jen.Err().Op(":=").Id("wal").Dot("file").Dot("Sync").Call(), jen.Err().Op("!=").Nil()).Block(
+				cutOff(jen.Id("wal").Dot("size"), jen.Err()),
+				jen.Return().Err(),
+			),
+		)
+		appendFunc.Id("wal").Dot("size").Op("+=").Int64().Call(jen.Len(jen.Id("record")))
+		appendFunc.Return().Nil()
+	}).Line()
+
+	if proj.Errors {
+		// cut off records appended after the size (batch which is not applied to storage)
+		code.Func().Add(recv()).Id("truncate").Params(jen.Id("size").Int64()).Error().Block(
+			cutOff(jen.Id("size")),
+			jen.Id("wal").Dot("size").Op("=").Id("size"),
+			jen.If(jen.Id("wal").Dot("policy").Op("==").Id(proj.Name+"SyncEachCommit")).Block(
+				jen.Return().Id("wal").Dot("file").Dot("Sync").Call(),
+			),
+			jen.Return().Nil(),
+		).Line()
+	}
+
+	// read single record. Declared length is checked against rest of the log before allocation: record which doesn't
+	// fit is cut off by the end of file. Size of record is returned with checksum error to locate it
+	code.Func().Id("read"+walType+"Record").Params(jen.Id("reader").Qual("io", "Reader"), jen.Id("remaining").Int64()).Params(jen.Index().Id(proj.Name+"LogEntity"), jen.Int64(), jen.Error()).BlockFunc(func(readFunc *jen.Group) {
+		readFunc.Var().Id("header").Index(jen.Lit(walHeaderSize)).Byte()
+		readFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Qual("io", "ReadFull").Call(jen.Id("reader"), jen.Id("header").Index(jen.Empty(), jen.Empty())), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Lit(0), jen.Err()))
+		readFunc.Id("length").Op(":=").Int64().Call(jen.Qual("encoding/binary", "BigEndian").Dot("Uint32").Call(jen.Id("header").Index(jen.Lit(0), jen.Lit(4))))
+		readFunc.If(jen.Id("length").Op(">").Id("remaining").Op("-").Lit(walHeaderSize)).Block(
+			jen.Return(jen.Nil(), jen.Lit(0), jen.Qual("io", "ErrUnexpectedEOF")),
+		)
+		readFunc.Id("payload").Op(":=").Make(jen.Index().Byte(), jen.Id("length"))
+		readFunc.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Qual("io", "ReadFull").Call(jen.Id("reader"), jen.Id("payload")), jen.Err().Op("==").Qual("io", "EOF")).Block(
+			jen.Return(jen.Nil(), jen.Lit(0), jen.Qual("io", "ErrUnexpectedEOF")),
+		).Else().If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Lit(0), jen.Err()),
+		)
+		readFunc.Id("size").Op(":=").Lit(walHeaderSize).Op("+").Id("length")
+		readFunc.If(jen.Qual("hash/crc32", "ChecksumIEEE").Call(jen.Id("payload")).Op("!=").Qual("encoding/binary", "BigEndian").Dot("Uint32").Call(jen.Id("header").Index(jen.Lit(4), jen.Lit(walHeaderSize)))).Block(
+			jen.Return(jen.Nil(), jen.Id("size"), jen.Id(errChecksum)),
+		)
+		readFunc.Var().Id("batch").Index().Id(proj.Name + "LogEntity")
+		readFunc.If(jen.Err().Op(":=").Qual("encoding/gob", "NewDecoder").Call(jen.Qual("bytes", "NewReader").Call(jen.Id("payload"))).Dot("Decode").Call(jen.Op("&").Id("batch")), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Lit(0), jen.Err()),
+		)
+		readFunc.Return(jen.Id("batch"), jen.Id("size"), jen.Nil())
+	}).Line()
+
+	// replay snapshot and log to storage. Torn tail (incomplete or corrupted last record) is cut off, corrupted record
+	// in the middle of log is an error
+	code.Func().Add(recv()).Id("replay").Params(jen.Id("storage").Id(proj.Name + "TxStorage")).Error().BlockFunc(func(replayFunc *jen.Group) {
+		replayFunc.List(jen.Id("snapshot"), jen.Err()).Op(":=").Qual("os", "Open").Call(jen.Id("wal").Dot("path").Op("+").Lit(".snapshot"))
+		replayFunc.If(jen.Err().Op("==").Nil()).Block(
+			jen.Err().Op("=").Id("Load"+proj.Name+"Storage").Call(jen.Id("storage"), jen.Qual("bufio", "NewReader").Call(jen.Id("snapshot"))),
+			jen.Id("_").Op("=").Id("snapshot").Dot("Close").Call(),
+			returnIfErr.Clone(),
+		).Else().If(jen.Op("!").Qual("os", "IsNotExist").Call(jen.Err())).Block(
+			jen.Return().Err(),
+		)
+		replayFunc.Id("reader").Op(":=").Qual("bufio", "NewReader").Call(jen.Qual("io", "NewSectionReader").Call(jen.Id("wal").Dot("file"), jen.Lit(0), jen.Id("wal").Dot("size")))
+		replayFunc.Var().Id("offset").Int64()
+		replayFunc.For().BlockFunc(func(loop *jen.Group) {
+			loop.List(jen.Id("batch"), jen.Id("size"), jen.Err()).Op(":=").Id("read"+walType+"Record").Call(jen.Id("reader"), jen.Id("wal").Dot("size").Op("-").Id("offset"))
+			loop.If(jen.Err().Op("==").Qual("io", "EOF")).Block(jen.Break())
+			loop.If(jen.Err().Op("==").Qual("io", "ErrUnexpectedEOF").Op("||").Parens(jen.Err().Op("==").Id(errChecksum).Op("&&").Id("offset").Op("+").Id("size").Op("==").Id("wal").Dot("size"))).Block(
+				jen.Id("wal").Dot("size").Op("=").Id("offset"),
+				jen.Return().Id("wal").Dot("file").Dot("Truncate").Call(jen.Id("offset")),
+			)
+			loop.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return().Qual("fmt", "Errorf").Call(jen.Lit("WAL record at offset %d: %w"), jen.Id("offset"), jen.Err()),
+			)
+			if proj.Errors {
+				loop.If(jen.Err().Op(":=").Id("storage").Dot("Apply").Call(jen.Qual("context", "Background").Call(), jen.Id("batch")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+			} else {
+				loop.Id("storage").Dot("Apply").Call(jen.Id("batch"))
+			}
+			loop.Id("offset").Op("+=").Id("size")
+		})
+		replayFunc.Return().Nil()
+	}).Line()
+
+	// compact: replace snapshot by current content of storage and truncate log
+	code.Func().Add(recv()).Id("compact").Params(jen.Id("storage").Id(proj.Name + "TxStorage")).Error().BlockFunc(func(compactFunc *jen.Group) {
+		compactFunc.Id("tmpPath").Op(":=").Id("wal").Dot("path").Op("+").Lit(".snapshot.tmp")
+		compactFunc.List(jen.Id("file"), jen.Err()).Op(":=").Qual("os", "Create").Call(jen.Id("tmpPath"))
+		compactFunc.Add(returnIfErr.Clone())
+		compactFunc.Id("writer").Op(":=").Qual("bufio", "NewWriter").Call(jen.Id("file"))
+		compactFunc.Err().Op("=").Id("Save"+proj.Name+"Storage").Call(jen.Id("storage"), jen.Id("writer"))
+		compactFunc.If(jen.Err().Op("==").Nil()).Block(jen.Err().Op("=").Id("writer").Dot("Flush").Call())
+		compactFunc.If(jen.Err().Op("==").Nil()).Block(jen.Err().Op("=").Id("file").Dot("Sync").Call())
+		compactFunc.If(jen.Id("closeErr").Op(":=").Id("file").Dot("Close").Call(), jen.Err().Op("==").Nil()).Block(jen.Err().Op("=").Id("closeErr"))
+		compactFunc.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("_").Op("=").Qual("os", "Remove").Call(jen.Id("tmpPath")),
+			jen.Return().Err(),
+		)
+		compactFunc.If(jen.Err().Op(":=").Qual("os", "Rename").Call(jen.Id("tmpPath"), jen.Id("wal").Dot("path").Op("+").Lit(".snapshot")), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		// rename is durable only after sync of directory: log shouldn't be truncated before it
+		compactFunc.List(jen.Id("dir"), jen.Err()).Op(":=").Qual("os", "Open").Call(jen.Qual("path/filepath", "Dir").Call(jen.Id("wal").Dot("path")))
+		compactFunc.Add(returnIfErr.Clone())
+		compactFunc.Err().Op("=").Id("dir").Dot("Sync").Call()
+		compactFunc.If(jen.Id("closeErr").Op(":=").Id("dir").Dot("Close").Call(), jen.Err().Op("==").Nil()).Block(jen.Err().Op("=").Id("closeErr"))
+		compactFunc.Add(returnIfErr.Clone())
+		compactFunc.If(jen.Err().Op(":=").Id("wal").Dot("file").Dot("Truncate").Call(jen.Lit(0)), jen.Err().Op("!=").Nil()).Block(jen.Return().Err())
+		compactFunc.Id("wal").Dot("size").Op("=").Lit(0)
+		compactFunc.Return().Id("wal").Dot("file").Dot("Sync").Call()
+	}).Line()
+
+	code.Comment("Compact writes snapshot of committed state and truncates write-ahead log").Line()
+	code.Func().Parens(jen.Id("project").Op("*").Id("impl" + proj.Name)).Id("Compact").Params().Error().BlockFunc(func(compactFunc *jen.Group) {
+		compactFunc.If(jen.Id("project").Dot("_wal").Op("==").Nil()).Block(jen.Return().Nil())
+		compactFunc.Id("project").Dot("_tx").Dot("Lock").Call()
+		compactFunc.Defer().Id("project").Dot("_tx").Dot("Unlock").Call()
+		compactFunc.Return().Id("project").Dot("_wal").Dot("compact").Call(jen.Id("project").Dot("storage"))
+	}).Line()
+	return code
+}
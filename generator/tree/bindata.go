@@ -68,7 +68,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\xdb\x8e\x1b\x37\xd2\xbe\x56\x3f\x45\x45\x80\x1d\x29\x96\x34\x87\xc4\xc1\x1f\x25\x0a\x90\x4c\x9c\x60\xe0\xc4\x30\x6c\xff\xb9\xc9\x06\x0b\x8e\xba\x7a\x44\x4c\xab\x5b\xdb\xa4\x66\xac\x55\x1a\x08\xf6\x7a\x2f\xfd\x84\x7e\x92\x05\x8b\x87\x26\xfb\xa0\x83\xc7\x71\x82\xdd\x5c\xc4\x23\x1e\x8a\x55\x1f\xab\x3e\x16\x0f\x7d\x72\x02\x17\xf9\x6a\x53\xf0\xeb\x85\x84\xc1\x7c\x08\xe7\xa7\x67\x8f\x47\xf0\x64\xc9\x0b\x78\xce\x04\x9f\x4f\xe0\x9b\x34\x05\xaa\x17\x50\xa0\xc0\xe2\x16\xe3\x49\x74\x72\x02\xdf\xc4\x6c\x25\x31\x86\x24\x2f\x60\x9e\xc7\x38\xbe\xc6\x0c\xae\x36\x50\x60\x1c\xe3\x7c\xa4\x24\x7d\xa1\xda\xfd\xbf\x40\xc8\x13\x90\x0b\x2e\x40\xe4\xeb\x62\x8e\xd4\x1c\xb8\x80\xeb\xfc\x16\x8b\x0c\x63\xd5\x8d\xc1\xb7\x2f\xbf\x1b\x0b\xb9\x49\x51\xf5\x4a\xf9\x1c\x33\x81\x20\x17\x4c\xc2\x9c\x65\x70\x85\x90\xe4\xeb\x2c\x06\x9e\x81\x5c\x20\xfc\x78\x79\xf1\xe4\xd9\xcb\x27\x90\xf0\x14\x49\x9f\xe7\x6c\x7e\xc3\xae\x51\x8d\x7f\x95\xb2\xf9\x8d\x2c\x10\x81\x2f\x57\x29\x2e\x31\x93\x02\x98\xaa\x19\x53\x15\xa8\x3a\xd5\xc9\xe8\x47\x0a\xbc\x2a\x10\x5f\xa2\x04\x96\xc5\xf4\xf7\x4f\x6c\x65\x9b\xbc\x94\xc5\x7a\x2e\xd7\x05\x29\x9d\xe5\x12\xe4\xa2\x40\x16\x83\x60\x89\x13\xf3\x02\x13\x2c\x30\x9b\xa3\x98\xc2\x42\xca\xd5\xf4\xe4\x04\xb3\xc9\x1d\xbf\xe1\x2b\x8c\x39\x9b\xe4\xc5\xf5\x89\xfa\x75\xf2\x02\xe3\x07\x4f\xce\x1f\xfc\xdf\xe9\x83\x2f\x3e\x25\x6d\xfe\xae\xb4\x89\x56\x46\xfd\xed\x76\x62\x2c\x29\xcb\x28\xe2\xcb\x55\x5e\x48\x18\x44\xbd\x7e\xb2\x94\xfd\x08\x00\x60\xbb\x1d\x43\xc1\xb2\x6b\x84\xc9\x25\x55\x8b\xb2\xa4\x8a\xfe\x76\x3b\x29\xcb\xaa\x11\x66\x71\x59\x46\xc3\x28\xda\x6e\x63\x4c\x78\x86\xd0\x9f\xe7\xcb\x15\x2b\x98\xcc\x8b\x7e\x59\x46\xaa\x11\x4f\x60\x72\x29\x9e\xe2\xe6\xd9\x7a\x59\x96\x91\x6e\x80\x30\x9d\xc1\x0d\x6e\x60\x0c\x59\x1e\xe3\xe4\x29\x6e\xa8\x31\xa6\x02\xa9\xc7\x85\x93\xd3\xe8\x33\xb9\x58\xae\x06\xb6\xd7\xd0\x75\x2b\xcb\xe8\x96\x29\x4f\xd1\x6d\x79\x26\x23\x71\xc7\xe5\x7c\x01\xdb\x68\xce\x04\xd2\x70\x5f\xbb\xe1\xa6\x64\x85\x6d\x3d\x83\xb3\xaa\xd1\x57\x9d\x8d\xc6\x67\x51\x8c\x09\x5b\xa7\xb2\x5e\x73\x1a\x69\x6b\x35\x24\xdb\xad\xfd\xb7\x89\xcb\x65\x76\xbb\x1f\x1a\xab\x00\x8c\x95\x46\x87\x41\x63\xfb\x10\x3e\x37\x47\x43\xd3\x6d\xf5\xd9\x21\xf8\x1d\x0b\xcd\xc9\x09\x05\x01\x2c\xf2\x34\x16\x80\x36\x88\x28\x90\xb1\x16\x49\x91\xdc\xac\xc8\x71\x5f\x6d\x56\xf8\x8c\x2d\xb1\x2c\x41\x50\xc8\xc0\x36\xea\xbd\xc8\x73\x09\xfa\xbf\x4f\x82\x36\xcf\xf2\x18\xa3\x9e\xe0\xff\x44\x53\xad\x0c\x2f\x23\x35\x74\xa3\x9d\x0a\x3c\x06\x82\x67\xd7\x29\x5a\x6d\xe0\x8e\xcb\x85\x61\x83\x0e\x35\xa8\x6b\xa5\x8a\x9a\x31\x8a\x0d\x85\x90\x6a\x55\x96\x51\xef\x67\x96\xae\x91\x0a\xe9\x2f\x5b\x3c\xcf\xd3\xbc\x00\xb8\xca\xf3\x14\x4e\x4e\x40\x5b\x3b\x06\x59\xac\x71\xa4\xec\x87\x31\x24\x2c\x15\x18\xf5\x7e\xc4\x44\x76\x18\xf7\x82\x78\xb5\xb5\xea\x39\x2b\x94\x0d\x2d\x55\x1a\x82\xcb\x4c\x48\x96\x49\xce\x24\x36\xb9\x2b\x4a\xd6\xd9\x1c\x9e\xe1\x5d\xd0\x7b\x30\xac\x89\x83\x2d\xcd\x75\x81\x72\x5d\x64\xf0\x30\xa8\xdb\x96\x66\xa0\xe7\x6b\x09\x3c\x13\x58\x48\x41\xde\xa3\xa6\x21\x77\xa0\x12\xb5\x2a\xdc\xc4\x22\x5f\xa7\x31\xb0\x78\x81\x05\x82\x69\x51\x85\xcd\xc7\x02\x08\x7e\x26\x94\x24\x9e\x67\x23\xc8\xe5\x02\x8b\x3b\x2e\x10\x96\x28\x17\x79\x0c\x2b\x96\xf1\xb9\x98\x68\xe5\x07\xc4\xcf\xa1\xbe\x43\xa5\x8c\x8a\x8d\x60\x8a\x46\x70\x4b\x53\x14\xce\xd0\x50\x4d\xa8\x0a\x1b\xad\x3b\xc6\x34\xd7\x6d\x48\xf3\x44\x5b\x42\x8e\x38\x9b\x41\xc6\x53\xd5\xb7\xe7\x15\xd6\xb0\x51\xfd\xb6\x2a\x88\x54\x50\x8d\x80\x46\x9d\x6a\x2d\x46\x40\x8e\x31\xd5\x93\x5f\x46\xbd\x5e\x30\xfe\xac\x1a\x2a\xea\x95\x9a\x14\xd4\x58\x04\xec\x34\xa8\xed\xa5\x79\xbe\xd2\x65\x6b\x8c\x7a\x3d\xb5\x8e\x52\x91\x9e\x34\xfb\xdf\x76\x2b\x71\xb9\x4a\x99\x0c\xd9\x1b\x26\xca\x49\x7b\x3d\x47\x14\xbd\x5e\x8f\x68\xc0\x05\xf6\x0c\x4e\xa7\xaa\xb4\xe7\xc8\x8a\xe8\xb9\x2a\xd2\x8e\x3f\xd3\x76\x51\xb1\x76\x94\x86\xa8\xaf\xac\x24\x9e\x68\x7e\x21\x8f\xf7\x90\xb4\x12\x75\xf9\x7d\xc0\xec\x35\xf0\x74\x82\x75\x2d\x21\x34\xb3\xa1\xd7\xeb\xf9\x20\x1b\x35\x1a\x9d\xca\x86\x45\x5f\xd7\x2d\xd2\x81\xda\x62\x92\xa9\xf8\x03\x6c\x22\xc9\x47\x1b\x55\xf5\x22\xd1\xea\x7f\x75\x27\x9c\x18\x6a\xd1\x3d\x22\x55\x4f\x6e\xa7\xdb\x5c\x30\x81\x67\x03\xbf\xfd\xd0\xd4\x2b\x26\x7e\xf4\xc8\xb0\xc2\x0f\x28\x41\x20\x2b\xe6\x0b\x14\x14\xea\x86\x1a\x1c\x31\xa8\x94\x49\x45\xaa\x4a\x97\xb4\xdf\x08\xe0\x52\x98\x58\xcd\x0b\x42\x92\x27\xd4\xc6\x64\x4d\x55\xfe\x66\x89\xe5\x25\xce\x73\xd7\x1f\x94\x6f\x2f\x51\x62\xa1\x3a\xa8\xa8\xb0\xfd\xef\x98\xd0\x9d\x7d\x52\x21\xb4\x3e\x30\x3d\xfd\x80\x4d\x7a\x1a\xc2\xa0\x8d\x9f\x46\xc6\x5c\xb5\x80\x10\x57\x05\x14\xf0\x63\x9e\xdf\xac\x4d\x1a\x60\x9d\x10\x3e\xaa\xdc\xcf\x20\x52\xc5\xe9\xc8\xd0\x44\x19\xb9\x3a\x9e\x8e\x8c\xcb\xe8\x29\x7b\x81\xcb\xfc\x56\xad\xcd\xf4\x8f\x9b\xb3\xa4\xc8\x97\xf5\x59\xfb\xc0\xb0\x69\xcd\x5a\x90\x33\x14\x3e\x5f\xf0\x34\x6e\xe5\xee\xfd\xa0\xcd\xea\xa0\x11\x46\x01\x53\x19\x5c\x1f\x3e\xf4\x63\xdd\x03\x7b\xa5\x96\xf3\xa9\xc7\x1a\x93\x25\x7b\xcd\x97\xeb\xa5\xd2\x61\x30\x8c\x02\x0a\x55\x8d\x29\x1f\xae\xb1\x28\x95\xff\x6c\xa8\xd4\x44\xac\x2a\x6b\xaa\x63\x34\xfe\xed\xb7\x0e\xea\xe9\xe6\x24\x8d\x53\x48\x6f\x3e\x55\x04\xf5\x96\x29\x4a\x4f\xa4\x4e\x6a\x66\x7a\xcd\xd1\x7d\xfc\x72\x6a\x74\xa1\xfe\x1e\x90\x28\x65\xba\xe6\x86\x18\x53\x94\xa8\xb9\x23\xd3\x9c\x41\x82\xa9\xb2\xc0\x55\xca\xe6\x48\x68\xa9\xca\x11\xb8\xde\x76\x60\x4b\x49\x6e\x26\xb4\xaa\x1f\xd5\x8d\x73\x9a\x98\x45\xb1\xac\xe8\x4b\xd1\xd3\x78\x6c\x7c\xfd\xc9\x72\x25\x37\x8e\x77\x2c\x57\x90\xf3\xc5\x39\x6a\xba\x99\xe7\x99\x64\x3c\x03\x96\x6d\x48\x09\xb1\xcb\x45\x49\xe0\x60\xa8\xf3\xbd\xad\x0b\x32\x37\x32\xad\xa7\x66\xf0\x97\xea\xb7\x1d\x3b\x5b\x2f\xaf\xb0\x50\x89\x31\x8d\xe1\x53\xe4\xce\x98\x50\x42\x06\x43\x95\x6c\xb5\x0d\x67\x46\x7a\x8a\x1b\xe1\x46\x62\x69\xaa\x62\x57\x0d\x31\xce\x8b\x18\x8b\x5d\xe2\x55\xcf\xc1\x10\x7e\xf9\xd5\x0f\x38\x35\x12\x49\x98\xce\x60\xc9\x6e\x70\x10\x56\x8f\xaa\xf1\x55\x80\x49\x17\x78\x97\x12\x89\x0e\x54\x2c\xa8\x34\x85\xab\x9a\xd3\x2f\x81\xcb\xc9\x33\x7c\x2d\x07\xc3\x2f\x81\x3f\x7a\x44\xd3\xa8\xc4\xff\xc2\x7f\x85\x99\xaa\x7c\x8a\x1b\xd5\xa5\xe2\x2c\x55\x6b\x4c\xa3\x50\x09\x8d\xbb\xd5\x45\xd6\x3c\xb8\x62\x6a\x6b\x9e\x6b\x40\x89\xb5\x76\x18\xac\xe5\x59\x93\x3d\x22\xd6\x24\x43\x92\x03\xb3\x03\xae\xbe\xbf\xe1\x7a\x08\x67\x3a\x49\x0f\x8d\xd7\x2d\x8c\xf9\xc4\x04\xce\x7f\x17\x08\x29\x26\x72\xbc\xcc\x85\x84\xc1\x92\x67\x43\x4d\x6e\xd5\x32\xaa\x4f\x33\x04\xa0\xf2\xd3\x9d\x40\x28\xc9\x8d\x9d\x00\x25\x1e\x86\x6d\x57\x9d\x3b\x8f\xde\x7c\x5d\x50\x5d\x98\xa9\xd2\x09\x8f\xa9\xf1\x69\xd3\xa6\x19\xa6\x2e\xea\xb9\xfe\xae\xcc\xb0\x54\x05\x82\xee\x64\x57\x2c\x62\x38\x1f\x05\x3a\x68\xb2\x30\xb0\xd7\xf7\x80\x81\x64\xff\x75\x70\x30\x6c\xdc\x01\xc4\xf7\x69\x9e\x17\xf0\x3d\xcf\x62\x01\x09\xfd\xad\xed\xd6\xbb\x6d\x9e\xad\xd6\x52\x67\x9a\x96\x27\x16\x18\xb4\x73\xf8\x64\xb9\x29\xe7\x26\x63\x3a\x34\xcd\xd2\xbd\x76\x27\x5a\x95\xa6\x99\xd9\x92\xeb\xe3\x93\x18\x98\xf1\x61\x56\x5c\xa3\x90\xba\x9a\x4e\xee\xb8\x00\xb1\x64\x69\x8a\x85\xfa\x9d\x29\x4d\xf1\x1f\x6b\x96\xda\x54\xe3\x9a\xdf\xa2\x49\x73\xe8\x4c\xd1\xb7\x6a\xc9\x36\xc4\xe3\xf6\xe4\x6f\x04\xc8\x95\x52\x70\x85\x73\xb6\x16\x58\x25\x35\xd6\x21\x46\x4a\xbe\xa9\x55\xe2\x14\xa9\x34\x58\x19\xd4\x06\x80\x34\x35\x3a\x35\xf5\xf8\xb0\xd9\x11\x41\xda\x96\x56\x6a\x2c\x9a\xfe\xd9\x48\x2d\xf5\x4f\xb7\x81\x68\xd9\x6c\x26\x16\x54\xe7\xb7\x87\xed\x2f\xbd\xed\x65\xfb\xee\xd2\x4b\x54\x6d\x8a\xda\xbe\x79\x6c\xd9\x99\xb5\x6e\xc9\xc8\x68\x6b\xe1\x2c\x14\xdc\xba\x13\x2a\x6d\x86\xa5\xbb\x78\xd9\xb3\x11\xb5\x37\x71\xbe\x40\x9e\xf2\xec\x1a\x12\x8a\xbf\xb9\xf9\xb5\x3f\x02\xc3\x96\x7e\x0c\xda\x9a\x63\xa3\xd0\xf6\xdb\x1f\x87\x17\xfe\xd8\xcd\x48\xd4\x41\x57\x0f\x45\xdf\xeb\xf7\x46\x62\x60\xdd\x1f\x19\x8b\x01\x41\xfc\xd9\xc1\x68\x70\x6d\x0b\x47\x0b\xc8\x7f\x49\x40\x1a\x73\x0e\x09\xb5\x5d\xf1\x7a\x5c\x48\xba\x41\xf7\x07\x65\x8a\xac\x30\x9b\x59\xe1\x79\x4f\xb0\x9d\xdd\x3d\x95\x4a\xc2\x80\xe6\xc3\x3f\xea\xcb\x78\xea\xed\x28\xc0\x4b\xeb\x65\xa1\xa6\xd7\x65\xa4\x20\x6c\xc1\xaa\x40\x81\x99\x64\xca\xad\x14\x25\x98\x9d\xc5\xee\x0c\x5c\x8b\x1b\x0c\xad\x98\x6d\xd4\x13\xb2\x50\x6e\xd0\x7f\x81\xf1\xb7\x29\x9b\xdf\xbc\x2a\x10\xff\x96\xf5\x09\xaa\x8f\x48\x25\xbb\x0d\x51\x90\xe5\x6b\xb9\x5a\xcb\x81\xd3\x7d\x04\xfd\xfe\xc8\x1c\x40\x3f\x14\xb2\x08\x92\x4b\x21\x0b\x65\x86\xd6\x27\x6b\x3f\x0a\x6d\xd5\xc9\x72\xe5\x52\x4e\x5e\xae\x0a\x9e\xc9\x64\xd0\x7f\x70\xdb\x1f\x41\x75\x85\x63\xe5\x1a\x85\x3a\xa4\x8f\xd4\x76\x37\xe1\xaf\x8d\xec\x11\x70\xf1\x8a\xf1\x94\x82\x62\xa4\x0a\xe1\x13\x5d\x43\xd6\x85\x9b\x5c\x2f\x9b\xca\xf0\xee\xb9\x96\x33\x9d\x19\x89\x7a\x1f\x69\xc4\xe9\x4d\xab\x6b\xf4\x68\x06\xfd\xb7\x6f\xfe\x05\x00\xfd\xda\x66\x38\x6c\x03\xb6\x45\x05\x6c\x35\xfe\x08\x5c\x63\xe3\x84\xa4\xb0\x06\x58\x69\xad\x44\x58\x5d\x42\x55\x6c\x6d\xff\xed\x9b\x37\x6f\xdf\xfc\xfe\xf6\xcd\xef\x6a\x18\x4f\x0f\xaf\xc1\xbf\xbd\x06\x95\x5c\x52\xc3\x4d\xcc\x23\xe8\x5b\x8f\x68\x1c\x60\xbc\x23\x3c\xb0\x17\x1c\x0f\xc0\x1a\x3c\x6a\xf8\x00\x1d\xed\x7e\x16\x1c\xe7\x71\xed\x3b\x11\x77\x5e\x53\xa3\xd2\xf6\x9c\xfc\x4f\x62\xc9\xfb\x26\x2c\xdd\x04\x58\x51\xdb\x01\xa1\x79\x5d\xb0\x2c\xd6\x9b\x82\xce\x5d\x4b\xed\xa0\xd0\x1e\x68\x99\xc3\x95\x8e\xe3\x43\x5d\x6b\xfe\x39\x5e\xaf\x75\x36\x4f\x71\xaf\x46\xb5\x33\xad\xf0\xb8\x27\x2c\xad\x55\xfa\xea\x2a\x66\xf6\xf4\xf3\xfa\x08\x7e\x45\xcb\xf2\xf0\x00\x8d\x5d\xdb\xfb\xe8\xdc\xa2\x96\xdf\xcf\x53\x8d\x22\xb4\x03\xf4\xc6\xae\xaf\xde\x71\x4f\x04\x15\xb9\x64\x12\x69\x47\xdf\x69\xae\xe2\x70\x22\xd1\x69\xe8\x84\x1d\x47\x73\xd4\x76\x18\x85\xb7\x1b\x54\x68\xbc\x9c\x27\xde\xcf\xc0\xa9\x5c\x69\xcb\x0d\x83\xd7\xc5\x96\x06\xa8\x6a\x99\x07\x99\xab\x77\xee\xbb\xec\x4d\xd5\x30\xd3\x20\x36\x3b\xac\x55\x2d\xad\xb1\x46\x39\x55\x64\x31\xe2\x89\xf7\xd3\xb7\xb5\x2a\x6d\xb1\xd5\xeb\xd2\x6e\x6b\x7a\xc0\xcc\x7a\xaa\xe6\xad\x87\xdf\x44\xbb\x5d\x08\xf0\x04\xf2\x34\x6e\xf1\xd9\x20\xd3\xc1\xbb\x60\x29\xd2\x9d\x54\xeb\xaa\x6f\xe5\xbe\xbd\x7a\xa1\x11\x10\xac\x1b\x5e\x1b\x07\x80\x6e\xe4\x42\x04\xef\x6a\xeb\x55\x85\x4b\xd5\x7b\xff\xe2\xe1\xdf\x56\xed\xf2\x85\xf6\x13\x66\x7b\xe7\x5a\x3b\x4f\xf6\x4c\xa9\x5d\x89\x9d\xdb\x63\xed\xc3\xf5\x3a\x3f\x44\x2f\x7d\x9a\xee\x69\x38\x0c\x8e\xdf\xbd\x2b\x8b\x9a\x42\x9f\x1a\x85\x0e\xd5\xe6\xd3\x9d\xda\x10\x8b\xbb\x90\x31\x9c\x5e\x53\x92\x4a\x49\x3d\x4a\x81\x2a\x0c\xcd\x84\xd7\x8e\xe6\xa9\x79\xbd\x90\xda\x07\x4b\x99\x6b\x61\x6f\x37\x1b\x77\x91\xcd\x3e\xc3\x5d\x33\xf5\xd9\xf1\x33\xf5\xd9\x4e\x6c\xbc\xa1\x1d\x42\x81\x3a\x9d\xd4\xaf\x43\xa0\xb6\x0c\xcf\x66\xfe\x5a\x5e\xc5\x97\x66\xa8\x90\xd0\xad\x4f\x44\x6d\xe9\x46\xe9\xde\x14\x75\x2e\x3b\xbb\x87\xd6\xea\xd5\xc6\xae\xd8\xb5\x63\xf0\x6a\xcd\xaa\x01\xff\xf8\x48\x8f\x7c\xbc\x13\xf5\x6e\xcf\x3a\x60\x3e\x7c\x2b\x6b\xfe\xf5\xee\x70\xb5\xcd\x94\x46\xcb\x6b\x35\xdc\x37\x2f\x87\xf8\x44\xeb\xc4\x90\x53\xd4\x46\x3a\x20\xdb\x09\xae\x2a\x0f\xcb\x78\x5a\x72\x1b\x9b\x68\xb7\xec\xc7\x5a\x5d\xc3\xcf\x9f\x77\xfb\x43\xfd\xe6\xf0\x78\x1e\xaf\x73\x64\x25\xf1\xfc\x20\x8f\xac\xb7\xef\xd2\xc0\xe4\x8d\xce\xe7\xaa\x9c\x33\xe4\x49\x53\x7e\x00\x53\x5a\xca\x33\x3d\xea\x64\xb9\x2f\xa1\xdc\x47\x19\xfe\xc2\xbc\x2f\xc2\xcb\x36\xfc\x0e\x5b\x63\xea\xed\xef\x8b\x5f\xeb\x62\xf8\xf0\xa1\xc1\xb0\x89\xf1\x8e\x7a\xc2\x6a\x6f\x23\x02\x24\x5c\x77\xeb\x33\x12\x2c\x4e\x75\x97\xad\x60\xac\xaf\x4a\x55\xcb\x83\x57\xa5\x7a\x97\xf7\x8d\xa6\xf6\xc8\xbf\x08\x9c\xdd\x24\xdf\x8d\xe4\xe3\xe3\x91\xdc\xbd\xd2\xec\x47\xb2\x63\xb1\xb8\x1f\x84\xbb\x26\xe2\x18\x0c\x7d\xc1\x75\x06\x69\x44\xbd\xd5\xf2\xd0\x55\xea\x9d\x4c\xac\x94\xdf\x69\x63\xe8\x4c\x87\x98\xa8\x37\x5c\xdd\x36\x12\x09\x7a\x26\x36\x08\xed\xf3\x23\x09\xed\xf3\x77\x75\x9c\xba\x25\xed\xf1\xb8\x2b\xcb\x39\x20\x4f\xd9\x8d\xfa\xf6\x58\xe0\x5a\xa9\xac\x6d\x65\xab\xfb\xf0\xf6\x58\x2f\xac\x8d\xe4\xa6\x23\x44\xa9\x15\x76\xfb\xfe\xa6\x3b\x65\x69\xdc\x1c\xb8\x6d\x9e\x1a\x47\xdf\x1d\xbc\xe3\x27\x2d\x1f\xea\x53\x95\xdd\x9f\xce\xc0\xe0\xfc\xf4\xec\x8b\xa1\x7e\x05\x6e\x1e\xa0\xd0\x3b\x7c\xe5\x8b\x74\x1b\x69\x0a\x3f\x16\x20\x14\xe8\x2d\x0f\xdf\x5d\xbf\xea\xf1\x3b\x85\x42\xf3\x15\xbe\x39\x77\xed\x7a\x9e\xbf\xca\x05\xa7\x6b\x8f\xab\x8d\x44\x7a\x08\x8f\xd7\x3c\x83\x19\x9c\x8e\xe0\x0a\xe5\x1d\xa2\xfa\x71\x36\x02\xa4\x3b\xa4\x73\x9a\x03\x5f\x71\xff\x3a\x85\x49\x4c\xd6\xa9\xd3\x1f\xee\x16\xb9\xc0\xea\xe3\x02\x56\xd0\x33\x9f\x13\xfd\x9c\x72\xc5\x78\xb1\xfb\xae\xae\x7a\x9d\xd3\x61\x7c\x75\xbd\xd1\x5a\xbf\x55\x52\xa7\x74\xde\xac\xaf\x3b\xa6\xfa\x1a\xca\xda\x3c\x85\x53\xfb\x48\xfe\x19\xbe\x96\xa0\xaf\xa2\xfc\x19\xb0\xd7\x90\x99\xaa\xb6\x9f\x25\xf8\x2f\x62\xdd\xcb\x34\xba\xb4\xbc\x63\x0a\x87\xa0\xb1\x71\x11\x77\xa3\x44\xfe\x71\x99\x80\x7e\x5e\x14\xc8\x19\xa9\x96\x59\xd0\xfd\x63\xe1\xde\xe0\x6a\xd0\x8c\xfb\x15\x28\x0b\x8e\xb7\xda\x5d\xe9\x15\x16\xb5\x31\x8f\x92\x6a\x63\x28\xad\xe6\x2c\x4d\x8d\x43\xd2\xdb\x12\x5e\x08\x09\x92\x2f\xed\xa0\x5c\xc2\x1d\x4f\x53\x58\xe5\x3c\x93\xad\x10\xe8\x2e\xce\xac\x44\x75\xc1\xd7\x5c\x48\x41\xc3\xfd\x94\xc7\x3c\xe1\x06\x3e\xf2\x04\x77\xb7\x6e\x04\xd9\xa9\x76\x82\x3f\x69\x9d\xb4\xa1\x55\xdb\x63\x0a\x27\xc2\x79\xeb\x6c\x06\xe7\x44\x1a\xd7\xb9\xcc\x95\x6f\xda\xe3\xa9\xd6\xa6\xa7\xee\xcc\x4f\x71\xbd\x6b\xa2\x1f\x9d\xd2\x73\x2a\x9d\xad\xa7\xf5\x77\xef\x95\x74\xfd\xcc\xd2\xf6\x34\x9b\xa6\x54\x5f\x1b\x50\x2b\x13\x2c\x0d\x3d\xda\x77\x5d\x75\x49\x2d\xcd\xcd\x27\x03\x61\x4d\xfd\xcc\x76\x8f\x20\xfb\x86\xf4\x20\x25\x9b\xd7\x0c\x99\xb9\xb1\x09\xda\xb5\xea\xd5\xec\xbb\x47\x33\x7b\x4c\x48\x2f\x0a\x1b\x63\x98\x07\xb8\xbd\xf6\x5b\xa0\xcb\xec\xd6\x7d\x1f\xc1\x93\xea\x62\xc7\x4e\x74\xdd\x58\xf7\x8c\xbe\x8c\x22\xcc\xe2\x69\xd4\xd0\x8d\xb6\xc9\x2d\xae\x03\xe7\xd5\x05\x2a\xe5\x32\x91\x11\x3a\x6d\x6f\x7e\x16\x05\xab\x98\xf9\x00\xa7\xc0\xdb\x1d\xdc\xb2\x2a\xf0\x96\xe7\x6b\x71\x30\xbf\x34\x3a\x74\x71\x8c\x1a\xb8\x9d\x63\xea\x22\xee\xc1\x33\xef\x31\xf0\x8d\xbe\xfb\x02\xff\xb4\x0a\x7c\x5a\xac\x76\x86\xfe\x79\x75\xb5\xd1\x8c\x7d\xf3\x86\x50\x07\x7f\xd1\x78\x8e\xed\x0f\xd1\x1a\xff\x85\x89\xd3\xfd\xb1\x55\x8f\xdb\x83\xc2\xb6\x19\x66\x0d\x1e\x39\x90\x48\xfe\x37\x08\xe0\xeb\x43\x08\x80\xe6\xf3\x18\x0a\x38\x7d\x0f\x14\xa0\x3f\x22\xf0\x1f\xc2\xda\x17\xa4\x55\x00\x52\xe0\x51\x48\x7d\x67\x5f\xb8\x2f\x55\x6c\x6d\xde\x43\x64\x99\x80\x85\xe6\xcb\x69\xa3\x6b\x08\xbe\xfe\xcc\xc1\x3d\x51\xdf\xa3\xb8\xfd\xde\xe4\xfd\xab\xad\x19\x87\x67\xf2\xf3\xcf\x3a\x75\x55\x8e\xa2\x35\xfd\x96\x32\x57\x95\xf0\xcb\x26\xd3\x72\x29\x80\x67\x5c\x72\x96\x1a\xa5\x06\x79\x86\xe3\x2b\x4c\xf2\x02\xc7\x94\xdc\x0c\x69\x6b\xc1\xd2\xd4\x66\x20\x32\x87\x04\xe5\x7c\xd1\x9e\xff\xb0\x6c\x73\xb0\x21\xa4\x9a\x7e\xf6\x73\x84\xdf\x99\x4f\x21\xb2\xb8\x6d\xf5\x58\x31\x21\xcd\xa3\x5c\x4f\x2f\x32\x4a\x55\x8d\xe5\x02\xc7\x98\xc5\x9a\xa4\xc9\x2a\x43\xaf\x81\x55\x41\xe7\x23\x8d\x7a\x92\xc5\x47\x9a\x74\x6e\x9f\x43\x13\x96\xdd\x4b\x62\x88\xf5\xbe\xf5\xb0\x36\x33\x1d\x8b\x21\x8d\xd9\xbe\x1a\x06\x02\xfe\xd0\xa5\xf0\x50\x68\xad\xb2\x6e\x29\xb4\x60\x1a\x47\x6a\xc6\x82\x76\x59\xfb\xf1\x01\xdb\x09\x6f\x30\xe9\xfb\xd0\x0d\x3d\xa4\x03\x5c\x35\x60\x3b\xb6\x7e\xf7\xbf\x46\x96\x61\x74\x6d\x40\x4b\xee\xdc\x04\x56\x47\x4d\x54\x46\xff\x09\x00\x00\xff\xff\xa2\xeb\x42\xee\x4b\x43\x00\x00")
+var _templateGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xe5\x5b\x6d\x6f\xdb\x46\x12\xfe\x2c\xfd\x8a\xad\x81\xa4\x52\x23\xcb\x2f\x69\x8a\xab\xae\x0e\x90\xb8\xee\x21\xb8\x36\x08\xe2\x5e\xbf\xf4\x8a\x03\x4d\xae\x6c\xc2\x14\xa9\x92\x94\x1d\x9d\x6a\xe0\x70\x9f\xef\x63\x7f\x61\x7f\xc9\xcd\xcb\xee\x72\x97\x5c\x52\x54\xec\xa6\xc1\x5d\x50\xd4\xd4\xee\xec\xec\xcc\xec\xcc\xb3\xb3\x6f\x07\x07\xe2\x34\x5b\xae\xf3\xf8\xf2\xaa\x14\xa3\x70\x2c\x8e\x0f\x8f\x9e\x4d\xc4\xd9\x22\xce\xc5\x9b\xa0\x88\xc3\xa9\x78\x91\x24\x82\xea\x0b\x91\xcb\x42\xe6\x37\x32\x9a\x0e\x0f\x0e\xc4\x8b\x28\x58\x96\x32\x12\xf3\x2c\x17\x61\x16\xc9\xfd\x4b\x99\x8a\x8b\x35\x10\x45\x91\x0c\x27\xc8\xe9\x4b\xa4\xfb\x5b\x21\x45\x36\x17\xe5\x55\x5c\x88\x22\x5b\xe5\xa1\x24\x72\x01\x3f\x2f\xb3\x1b\x99\xa7\xc0\x03\x9a\x05\xe2\xe5\xf9\xd7\xfb\x45\xb9\x4e\x24\xb6\x4a\xe2\x50\xa6\xd0\xb2\xbc\x0a\x4a\x11\x06\xc0\x59\x42\x4f\xab\x34\x12\x71\x0a\x85\x52\x7c\xfb\xea\xf4\xec\xf5\xf9\x99\x98\xc7\x89\x24\x79\xde\x04\xe1\x75\x70\x29\xb1\xff\x8b\x04\xbe\xcb\x5c\x42\x27\x8b\x65\x22\x17\x32\x05\xe1\x03\xac\xd9\xa7\x2a\x81\x75\xd8\x48\xc9\x47\x02\x7c\x0f\x65\xe7\xb2\x14\x01\xf4\x81\xdf\xdf\x05\x4b\x4d\x72\x5e\xe6\xab\xb0\x5c\xe5\x24\x74\x9a\x95\x20\x40\x2e\x83\x48\x14\xc1\xdc\xb0\x79\x2b\xe7\x32\x97\x69\x28\x8b\x99\xb8\x2a\xcb\xe5\xec\xe0\x40\xa6\xd3\xdb\xf8\x3a\x5e\xca\x28\x0e\xa6\x59\x7e\x79\x80\xbf\x0e\xde\xca\xe8\xd1\xd9\xf1\xa3\x3f\x1d\x3e\xfa\xf2\x29\x49\xf3\x0f\x94\x66\xb8\xd9\xec\x8b\x78\x4e\xdc\xa7\x67\x8b\x0b\x34\x62\x74\x77\x37\x5c\x2a\xad\x36\x9b\xa9\x52\x10\x0a\x87\xa0\x56\x96\xc3\x80\x0d\x07\x7b\xf3\x45\xb9\x07\x7f\xe2\x52\xe6\x7b\x43\x01\xff\x90\x51\x1e\xa4\xd0\x66\xfa\x8a\xc8\x0a\x68\x81\x15\x7b\xc0\xe3\xee\xae\x22\x92\x29\x76\x30\x1e\x56\xdf\xf0\x19\xc9\x79\x9c\x4a\xb1\x17\x66\x8b\x65\x90\x07\x65\x96\xef\x41\x85\x12\x6e\x7a\x6a\x4a\xa1\x90\x49\xa4\x98\x9d\x88\x6b\xb9\x9e\x9e\x2e\x96\xa3\x14\x06\x76\xfa\x57\xb9\x56\x4c\x93\x02\xa5\xbd\x09\xd0\x41\x98\x36\x4e\xcb\x61\x71\x1b\x97\xe1\x95\xd8\x0c\xc3\x00\x06\x18\x9a\x8a\xe7\x42\x37\x9c\x91\x74\x9a\xfa\x44\x1c\x55\x44\x5f\xb5\x12\xed\x1f\x0d\x41\xec\x60\x95\x94\xf5\x9a\xc3\xe1\x9d\xa5\xde\x66\xa3\xff\x36\xb5\x7c\x95\xde\xf4\x51\x54\x8b\x40\xda\x5e\xef\xac\xe8\x57\x7d\x14\x7d\xfe\x60\x8a\x82\x5b\xa2\x27\x8b\xab\x2c\x89\x0a\x90\x52\x45\x02\x45\xa3\xac\x85\xc3\xb0\x5c\x2f\xc9\xcd\xbe\x87\xbf\xaf\x83\x05\x28\x24\x0a\xf2\x7b\x50\x60\xf0\x36\x03\xbf\xe4\x7f\x9f\x39\x34\xaf\x41\xd4\xe1\xa0\x88\xff\x29\x55\x35\x2a\x0e\x9e\x04\x5d\x37\xe8\x30\x7a\x02\x51\xc4\xe9\x65\x22\xb5\x34\x02\x6c\x74\xa5\x42\xba\x45\x0c\x6a\x5a\x89\x02\x76\x61\x0f\x46\x0b\x21\x15\x28\x3a\xf8\x21\x48\x56\x92\x0a\xe9\x4b\x17\x87\x59\x02\xe8\x24\x2e\xb2\x2c\x11\x20\x11\x6b\xbb\x0f\x1d\xad\xe4\x04\xf5\x87\xef\x79\x00\x83\x37\x1c\x7c\x2b\xe7\x65\x8b\x72\x6f\x09\x1c\xbd\x55\x6f\xc0\xfa\xa0\x83\xa7\x8a\x4d\xf0\x2a\x2d\xca\x20\x2d\xe3\xa0\x94\x4d\x00\x1a\xce\x57\x69\x28\x5e\xcb\x5b\xa7\xf5\x68\x5c\x63\x07\x3a\xa3\xbe\xb9\x04\x00\x4a\xc5\x63\xa7\x6e\x73\xa7\x3a\x7a\xb3\x2a\xc1\xf2\x80\xce\x65\x41\xde\x83\xc3\x90\x19\xa3\x12\x3e\xa2\xdd\x8a\xab\x6c\x95\x44\x22\x88\xae\x00\xab\x84\xa2\xa8\x82\xe0\xd3\x42\x90\xf9\x83\x02\x39\xc5\x59\x3a\x11\x19\x50\xe4\xb7\x31\xb8\xe6\x42\x96\x57\x59\x24\x96\x41\x1a\x87\xc5\x94\x85\x1f\x11\xc8\xba\xf2\x8e\x51\x18\x8c\x0d\x67\x88\x26\xe2\x86\x86\xc8\x1d\xa1\x31\x0e\x28\x86\x0d\xcb\x2e\x23\x1a\x6b\x9f\xa5\x21\x28\x49\x13\x72\xc4\x13\x08\xc4\x38\xc1\xb6\x03\xab\xb0\x66\x1b\x6c\xb7\xc1\x20\xc2\xa0\x9a\x08\xea\x75\xc6\x52\x4c\x04\x39\xc6\x8c\x07\x1f\xfc\x64\xe0\xf4\x7f\x52\x75\x35\x1c\xdc\x51\x74\x53\x5f\x64\xd8\x99\x53\x3b\x48\xb2\x6c\xc9\x65\x2b\x90\x72\x80\x93\x21\x15\xf1\xa0\xe9\x7f\x9b\x4d\x29\x61\x26\x02\x37\x70\x90\x55\x4c\xd1\x49\x07\x03\x03\x14\xf0\x4d\x30\x60\x02\x1b\x22\x7b\x86\xa5\x03\x0d\x09\x82\xc0\xb6\x2a\x62\xc7\x3f\x61\xbd\xa8\x98\x1d\xa5\xc1\xea\x2b\xcd\x89\x26\x19\x68\x49\x1e\x6f\x59\x52\x73\xe4\xf2\xfb\x18\x73\xd0\xb0\xa7\x61\xcc\xb5\x64\xa1\x13\x1d\x7a\xf0\xcf\x32\xb2\x12\xa3\xd1\xe8\xae\xa1\xd1\xf3\xba\x46\x1c\xa8\x1e\x95\x54\xc5\xef\xa0\x13\x71\xde\x59\xa9\xaa\x15\xb1\xc6\xff\xd5\x9d\x70\xaa\xa0\x85\x5b\x0c\xb1\x9e\xdc\x8e\x69\x4e\xc1\x0e\x47\x23\x9b\x7e\xac\xea\x11\x89\x9f\x3c\x51\xa8\xf0\x17\x48\x67\x0a\x19\xe4\xe1\x15\xc0\x0f\x86\xba\x82\x06\x03\x0c\x98\xf7\x60\xa4\x62\xce\xc3\x7e\x53\x88\x18\x20\x84\x63\x15\x7c\x19\x2d\x09\xe6\x45\x1a\x95\xfa\x54\x49\x98\x06\x96\x73\x19\x66\xa6\xbd\x40\xdf\x06\xa8\x90\x39\x36\xc0\xa8\xd0\xed\x6f\x83\x82\x1b\xdb\xa0\x42\xd6\xfa\xc0\xf0\x04\x56\x69\xc0\xd3\x58\x8c\x7c\xf8\x34\x51\xea\xe2\x04\x42\x58\xe5\x40\xc0\xb7\x59\x76\xbd\x52\x69\x80\x76\x42\xf1\x49\xe5\x7e\xca\x22\x55\x9c\x4e\x14\x4c\xc0\x60\xea\xba\x38\x99\x28\x97\xe1\x21\x7b\x2b\x17\x90\x14\x83\x31\xe9\x8f\x19\xb3\x79\x9e\x2d\xea\xa3\xf6\x81\xcd\xc6\x92\x79\x2c\xa7\x20\x3c\xbc\x8a\x41\x08\x1f\x76\x6f\x37\xda\x49\xdd\x68\x64\x23\x07\xa9\x94\x5d\x1f\x3f\xb6\x63\xdd\x32\xf6\x12\xa7\xf3\x99\x85\x1a\xd3\x45\xf0\x2e\x5e\xac\x16\x28\xc3\x68\x3c\x74\x20\x14\x89\xf1\x73\x58\x43\x51\x2a\xff\x41\x41\xa9\x8a\x58\x2c\x6b\x8a\xa3\x24\xfe\xe5\x97\x16\xe8\x69\xc7\x24\xb6\x93\x0b\x6f\x36\x54\x38\xf5\x1a\x29\xee\x2c\x96\x9c\xd4\x9c\xf0\x9c\xc3\x6d\xec\x72\x1e\x88\x29\x16\x9d\x62\x09\xe9\xce\xe0\x10\x41\xd2\x55\x4a\x06\x8f\x94\x41\x83\x38\x53\x65\x2e\x61\x82\x0a\x25\x99\x0b\x2b\x27\xcc\x68\x6c\xf5\xac\x31\xc9\x0c\x05\xcb\xfa\x49\x5d\x3b\x23\x8a\x9a\x15\xef\x2a\xfc\x42\x7c\xda\xdf\x57\xce\x7e\xb6\x58\x96\x6b\x03\x3c\x1a\x2c\xc8\xfb\xa2\x4c\x32\xde\x00\xb6\x94\x01\xc0\x4d\x90\xae\x49\x88\xa2\xcb\x47\x89\x21\x64\x50\x94\xf0\x6d\x4c\x94\x99\x9e\x69\x42\x55\x9d\x9f\xe3\x6f\xdd\x77\xba\x82\x25\x57\x8e\x99\x31\xf5\x61\x63\x64\x67\x50\x20\x13\xe8\x0f\xb2\x2d\x5f\x77\xaa\x27\x70\xb4\xc2\xf4\x14\xc0\x72\xfa\x1a\x0b\xe2\x74\x3f\xcb\x23\x99\x77\xb1\xc7\x96\xc0\xfe\xc7\x9f\xec\x88\xc3\x9e\x88\x03\x78\xfb\x22\xb8\x96\x23\xb7\x7a\x52\xf5\x8f\x11\x56\x9a\xc8\x7b\x05\xa0\x8c\x78\x80\x0e\x81\x79\x4a\x8c\x35\x87\x7f\x06\xc0\x9f\xbe\x96\xef\xca\xd1\x18\x3e\x9f\x3c\xa1\x61\x44\xf6\x3f\xc6\x3f\xc1\x08\x42\x25\x70\xc6\x26\x15\x68\x61\xad\x52\x8d\x62\xc5\x55\xee\x86\x8b\xb4\x7a\xe2\x22\xc0\x05\x76\xc6\x06\x25\xd8\xea\x50\x98\xf9\x69\x95\x2d\x24\x66\x94\x21\xce\x8e\xda\x0e\x58\xdf\x5f\x71\xee\xc2\xa8\x4e\xdc\x5d\xe5\x99\x42\xa9\x4f\x50\x60\xfc\x17\xf4\x4b\xa0\x60\x7f\x91\x15\xb0\x36\x5f\xc4\xe9\x98\xd1\xad\x9a\x47\x79\x4f\x02\x96\x62\xe8\xa7\x9d\x86\x40\xce\x8d\xa5\x00\x65\x1e\x0a\x6e\x97\xad\x4b\x8f\x41\xb8\xca\xa9\xce\x4d\x55\x69\x9f\x46\xd5\xd8\xb8\xa9\xf3\x0c\x55\x07\x45\x9a\xca\x94\x29\x98\xaa\x8c\xc0\x8d\xf4\x94\x45\x10\x67\x5b\x81\xb6\x8b\xb4\x19\x82\x77\xf7\x30\x03\xf1\xfe\x78\xec\xa0\xe0\xb8\xc5\x10\xdf\x40\x0a\x98\x8b\x6f\xe2\x14\x16\xdb\x73\xfa\x66\xbd\x79\xb9\x1d\xa7\x4b\x58\xa4\x51\xaa\xa9\x71\x02\x4a\x6d\x3a\x63\x9f\x34\x53\xe5\xb1\x4a\x99\xfa\xe6\x59\xdc\xaa\x3b\xd3\xaa\x24\x4d\xd5\x9a\x9c\x77\x43\x20\x81\x50\x3e\x1c\xe4\x97\x12\x86\x8e\xaa\x69\xff\x0d\x77\xee\x16\x10\xdb\xd0\x19\xfc\x4e\x51\x52\xf9\xf3\x2a\x48\x74\xae\x71\x19\xdf\x48\x95\xe7\xd0\xce\xa0\xad\xd5\x22\x58\x13\x8e\xeb\xfd\xbb\x89\x90\x31\x0a\x05\x05\x61\xb0\x2a\x64\x95\xd5\x68\x87\x98\x20\x7f\x55\x8b\xec\x10\x54\x1a\xa8\x2c\x70\x05\x40\x92\x2a\x99\x9a\x72\x7c\xd8\xf4\x88\x4c\xea\xcb\x2b\xd9\x16\x4d\xff\x6c\xe4\x96\xfc\xd3\xac\x20\x3c\xab\xcd\xb9\x36\xaa\xf1\xdb\x7e\x0b\x4c\x6b\x7d\xe9\x5f\x5e\x5a\x99\xaa\xce\x51\xfd\xab\x47\xcf\xd2\xcc\xbb\x26\x23\xa5\xb5\x86\x27\x2e\x63\xef\x52\xe8\x4e\xa7\x58\xdc\xc4\x4a\x9f\x15\xab\xad\x99\xf3\xa9\x8c\x93\x38\xbd\x14\x73\x8a\xbf\x50\xfd\xda\x1e\x81\x2e\xa5\x1d\x83\xba\x66\xd7\x28\xd4\xed\xb6\xc7\xe1\xa9\xdd\x77\x33\x12\x39\xe8\xea\xa1\x68\x7b\xfd\xd6\x48\x74\xb4\xfb\x3d\x63\xd1\x01\x88\x3f\x3a\x18\x95\x5d\x7d\xe1\xa8\x0d\xf2\x3f\x12\x90\x4a\x9d\x3e\xa1\xd6\x15\xaf\xbb\x85\xa4\xe9\x74\x7b\x50\x26\x12\xe6\x68\x5e\xcd\x16\x96\xf7\x38\xeb\xd9\xee\xa1\x44\x0e\x23\x1a\x0f\x7b\xaf\x0f\x7a\xb2\x56\x14\x6a\x07\xbc\xed\x10\x85\x8f\x70\x70\xd4\x4d\xa2\x8a\x1b\xca\x5c\xb0\xc4\x53\x2d\x58\x63\xa0\xb7\x21\x52\xa8\x05\x47\x77\x62\xce\xec\x40\x2c\xc5\x06\xa4\x83\x2f\xf4\x8e\xbd\xb7\x32\x7a\x89\x7b\xbc\xb8\xf3\xfe\xf7\x74\x8f\x2c\xf8\x09\x49\xaa\x57\x27\x68\xc9\x6c\x55\x02\x18\x8d\x8c\x4a\x13\xb1\xb7\x37\x51\x1b\xd3\x8f\x81\x95\x93\x73\xc2\x6f\xd4\x8e\xe5\x49\xfd\x5b\xa4\x5e\x99\x34\x84\x2e\xca\xe9\xf9\x12\x0a\xcb\xf9\x68\xef\xd1\x0d\x74\x54\x1d\xd4\x68\xbe\x4a\xa0\x16\xee\x13\x5c\x06\xcf\xe3\x77\x8a\xf7\x04\x00\xe2\xfb\x00\x1c\x1e\x63\x65\x82\x85\xe2\x33\xae\x21\xed\xdc\xc5\xaf\x95\x64\xa5\xf2\xf6\x0d\xf3\x99\x9d\x28\x8e\xbc\xbc\x54\xec\x78\x31\x6b\x88\x9e\x80\x39\x7f\xfb\xf5\xdf\x78\x76\x55\x5b\x24\xbb\x34\x42\x53\x54\x86\xad\xfa\x07\x65\x35\xb1\xf2\x4d\x12\x98\x0d\x8c\x52\x23\x0b\x2d\x8b\x2b\x8a\xae\x05\x21\x7e\xfd\xed\xd7\x7f\xc1\x7f\xd8\x8d\x25\x87\x45\xf0\x1f\x8b\xa0\xe2\x4b\x62\x98\x81\x79\x22\xf6\xb4\x47\x34\x36\x36\xde\xd3\x3c\x62\xab\x71\x2c\x03\xd6\xcc\x83\xdd\x3b\xd6\x61\xf7\xd3\xc6\xb1\x4f\x94\x3a\x97\x2a\x66\x47\xa7\x86\xb5\xfe\xa4\xfd\x0f\x82\xd1\xfb\x66\x34\xed\x08\x59\x61\x5f\x8f\x20\xbd\xcc\x83\x34\xe2\x55\x43\xeb\xb2\xa6\xb6\x95\xa8\xb7\xbc\xd4\xee\x4b\xcb\x06\x23\xd7\xaa\x3f\xbb\xcb\x05\xb5\x89\xdc\x2a\x51\x6d\xd7\xcb\xdd\x0f\x72\x4b\x6b\x95\xb6\xb8\x08\xdd\x96\x7c\x56\x9b\x22\xbe\xa0\x79\x7b\xdc\x43\x62\x43\x7b\x1f\x99\x3d\x62\xd9\xed\x2c\xd1\x28\x56\x5b\x8c\xde\x58\x16\xd6\x1b\x0e\xbb\x23\x28\xcf\x60\xfa\x91\xb4\xe4\x6f\x55\x17\xd1\x9c\xe0\x74\xe6\x3a\x61\xcb\xde\x1d\xd1\x8e\x87\xee\xf9\x07\x15\x2a\x2f\x07\x35\xab\x9f\x8e\x53\x99\x52\xcf\x19\x84\xd5\x44\x97\x3a\x56\x65\x9e\xbd\xd4\xe5\xa5\x7d\x97\xbe\xb8\x99\xe2\x6c\xe9\xb6\x6a\x8b\x94\x5a\x59\x25\x1c\x16\x69\x1b\x81\xae\xd5\x4f\x5b\xd7\xaa\xd4\xa3\xab\xd5\xc4\xaf\x6b\xd2\x63\x64\x2d\x51\x33\xef\xf6\x38\x01\x70\x9b\x05\x40\x70\x68\xe5\xf1\x59\x27\x15\x92\xb7\xce\xa4\xc4\x8d\x90\xba\x6a\x5b\xb9\xef\xa0\x5e\xa8\x18\x38\x33\x88\x45\x63\x0c\xc0\x44\x26\x44\x40\x68\x77\xe6\xaa\xec\x52\xb5\xe6\x69\xa4\xcb\x40\xf6\x79\x56\x97\x2f\xf8\xb7\xa0\xf5\xa9\x6c\x6d\xc3\xd9\x52\xa5\x76\x68\x76\xac\xf7\xbd\xfb\xcb\x75\xbc\x83\x5c\xf6\xae\xbb\xb3\x43\x6f\x9d\x6a\xd4\x24\x7a\xaa\x24\xea\x2b\xce\xd3\x4e\x71\x08\xc6\x4d\xcc\x28\x50\x27\x29\xe9\xbb\x2e\x1f\xa5\x43\x95\x15\x95\x12\xb5\xdd\x7b\x6e\x59\x2b\x24\x7a\x67\x32\x33\x14\xfa\x04\xb4\x71\x5e\xd9\x6c\x33\xee\x1a\xab\xcf\x77\x1f\xab\xcf\x3b\x8d\x63\x75\x6d\x4c\xe4\x88\xd3\x0a\xfe\x1c\x04\xb5\x89\x18\x68\xac\xd6\x55\x84\x31\x46\xb9\x90\xae\xda\x8c\x87\xbe\x84\x43\x99\xa0\x6b\xe2\xe9\xee\x9a\xc5\xab\xf5\x5d\xe1\x6b\x4b\xe7\xd5\xac\x55\x33\xfc\xb3\x1d\x5d\xf2\x59\xa7\xd5\xdb\x3d\xab\xc7\x78\xd8\x5a\xd6\xfc\xeb\xfd\xcd\xe5\x1b\x29\xb6\x96\x45\x35\xde\x36\x2e\x7d\x7c\xc2\x3b\x30\xe4\x14\xb5\x9e\x7a\xe4\x3b\xce\x71\x66\xbf\x9c\xc7\x93\xdd\xe8\x54\xdb\xb3\x36\xf3\xba\x86\x9d\x41\x77\xfb\x43\xfd\x70\x71\x77\x24\xaf\x83\x64\xc5\xf1\xb8\x97\x47\xd6\xe9\xdb\x24\x50\x99\xa3\xf1\xb9\x2a\xeb\x44\xe1\xd4\xaf\xdd\xa0\x52\x63\x9e\x6e\x5c\x43\xcb\x6d\x39\xe5\x36\xcc\xb0\xe7\xe6\x6d\x21\x7e\xe7\x33\xe0\xd3\x1d\x0d\xf8\xf4\x1e\x06\xdc\x36\x1f\x3e\x7e\x6c\x19\x6a\x2b\x01\x25\xa2\x5b\xa9\x38\x87\x6b\x9b\x7d\xeb\xa3\xe2\xcc\x50\x75\xbf\xad\x4c\x59\x9f\x9a\x2a\xca\xde\x53\x53\xbd\xc9\x83\x5b\x94\xdd\xf2\xe3\x31\x69\x3b\xda\xb7\x5b\xf3\xd9\xee\xd6\x7c\x76\x4f\x6b\xb6\xcc\x1a\xf7\xb5\xa2\x67\x34\xde\xcf\x8c\x4e\x27\x35\x30\x69\x00\x80\x22\xee\x3d\x63\xed\xa2\xa5\x57\x7c\x8f\x9a\xad\x3e\xd5\x47\x4b\xee\xa4\x5d\x4d\x82\x44\x4b\xcb\x06\xbc\x7d\xb1\x23\xbc\x7d\xf1\xbe\xee\x53\xd7\xa4\x25\x32\xbb\xb2\x9e\x2d\x79\x4b\x0f\xc3\x6f\x76\x35\x9d\x17\xd6\xac\xa9\xae\xdd\x95\x37\xbb\xfa\x62\xad\xab\x1e\x79\x8d\xdd\xaf\xbe\xb7\xd3\x9e\xc7\x34\x4e\x1c\xcc\xea\x0f\xbb\xe2\x33\x87\xf7\x7c\xd0\xf2\xa1\x1e\xaa\x74\x3f\x9c\x11\x23\x7c\x38\x33\xe6\xeb\xe3\xea\xe2\x0a\x5d\xe0\x47\x8f\xa4\x53\x4c\x55\xf8\x29\x48\x89\x76\xf7\xdc\x98\x37\xed\xaa\x5b\xf3\x14\x10\xcd\xeb\xfb\x6a\x3b\xb6\xed\x5e\xff\x32\x2b\x62\x3a\x17\xb9\x58\x97\x92\x6e\xd0\xcb\x4b\x50\xeb\x44\x1c\x4e\xe0\xb3\xbc\x95\x12\x7f\x1c\x4d\x70\x97\x18\x3e\x8e\x69\x0c\x6c\xc1\xed\xf3\x16\x90\x75\xbe\x4a\x8c\xfc\xe2\xf6\x2a\x2b\x64\xf5\x2a\x01\x37\x5b\xaf\xe5\xfa\x80\xef\x61\x2e\x83\x38\xef\x3e\xe3\xab\x6e\xf5\xb4\x28\x5f\x9d\x7f\x78\xeb\x37\xc8\x75\x46\xdb\xd0\x7c\x1e\x32\xe3\xe3\x2b\xad\xf3\x4c\x1c\xea\xdb\xf5\xba\xc9\x8b\xf2\x9e\x0a\x99\x33\xdc\x38\x85\x3e\x82\x24\xc6\xab\x51\x50\x12\xe0\x41\x72\x19\x87\xab\x24\xc8\xab\xd3\x5b\xbe\x9c\x44\x97\x73\xdf\xe4\xf2\x06\x3e\xcd\xa5\x50\xd3\xa7\x39\x48\xd3\x57\x7b\xc3\x2c\x07\xaf\x5e\x66\x29\x39\x4c\x14\xe7\x32\x44\x6d\x7a\x99\xf2\x45\xd7\x7e\xdc\x43\xd9\x98\x4f\x93\x8c\x91\x8f\xb4\x91\x29\x2c\x95\x75\x0b\xf9\xf3\x0a\xdf\x52\x61\x3c\xd6\x4d\x88\x77\x01\x0b\x88\x39\xd2\x8f\xaf\x98\x31\x55\xb7\xbb\x00\x7b\xbc\xa8\x07\x82\x4d\xcf\xe5\xcf\xc7\x3f\xba\x57\xe6\xdc\x9b\x64\x3f\xd9\x67\x67\xc0\x72\xb4\x8e\x65\x12\xf1\x67\x57\xbb\x71\x75\x76\xdc\x76\xff\x8c\x2f\xa0\xe9\x8b\x67\x9c\xd1\xe3\x01\x21\xf5\x30\xd2\xb7\xed\x26\xd6\xe5\x33\x45\x64\xdd\xeb\xbf\xd3\x59\x3e\x1b\xee\x65\x10\x5e\xdf\x06\x79\xd4\xd7\x7a\x91\x7c\x0f\xf3\xe9\x4e\x3e\x0a\x1b\x82\x71\xce\xd2\xc8\x36\xa7\x8a\x8f\x87\x30\x27\x8e\x8c\xe0\xf3\x6a\x27\xd2\xd4\x5d\x85\x14\xab\xf5\xe3\x25\xfb\xde\xbc\xb9\xbe\x4a\x37\x1b\xf0\xee\x47\xe0\x12\xab\xf9\xc0\x9c\x2f\x53\x8c\xbf\x9a\xeb\x30\xb7\xf9\x4c\x90\x32\x75\x9a\x03\xdc\xeb\x9b\xfa\x3c\x9e\x6a\xae\x81\x66\x79\x2c\x6f\x78\x6e\x22\x6d\x89\x46\x69\x5b\xeb\x03\xa5\x0a\xf1\x9e\x06\xcf\x3e\x74\x01\x0d\xbc\xa2\x14\x65\xbc\xd0\x9d\xc6\xf8\x26\x0b\x62\x71\x99\xe1\xad\x56\x9f\x09\xb8\x89\x51\x6b\x8e\x4d\xe4\xbb\xb8\x28\x0b\xea\xee\xbb\x2c\x8a\xe7\xb1\x32\x1f\xa1\xa4\xb9\x80\xa3\x18\x69\x4f\x33\x8c\x3f\xf3\xa2\xc7\x58\x8b\x6d\xa5\x05\x86\x85\x99\x9a\x20\x47\x38\xa6\x31\xbd\xcc\x40\x3e\x70\x6c\xbd\x45\xed\x25\x3d\x34\xfb\xfe\xe8\x5a\x86\x84\xaf\xa6\xd3\x9d\x4b\x5e\xae\x27\xf5\xd7\x31\x15\x77\xbe\x8c\xad\x5b\xaa\x6d\x93\x84\x8f\x0e\x89\x4a\xcd\x8c\x0d\x39\xfc\xfb\x2e\x75\x4e\x1e\x72\xe3\xe7\x76\x4d\xfd\xdc\x66\x0b\x23\x7d\xd3\xbc\x97\x90\xcd\xa3\x46\x7d\x6a\xeb\xd0\x79\xe5\x6a\xb6\xdd\x22\x99\x3e\x2a\xa0\x6b\xc7\x8d\x3e\xd4\x35\xfd\x81\xff\x24\x18\x9f\x6d\xea\x57\x54\x78\xf3\x4a\x1f\xee\xea\x81\xae\x2b\x6b\x45\xfb\x10\x06\x73\x36\x6c\xc8\x46\x1b\x65\x1e\xd7\x81\xe4\xc6\xc0\x19\x2d\x5f\x86\x8a\xe9\xcc\x4f\x7e\x34\x74\x52\x56\xf5\x4c\x0f\x60\xaa\x03\x5b\x96\x50\x1d\x67\xab\xa2\x37\xbe\x34\x1a\xb4\x61\x8c\xc2\x47\x0f\xc6\xd4\x59\xdc\x03\x67\x1e\x30\xf0\x95\xbc\xdb\x02\xff\xb0\x0a\x7c\xca\x4c\x3b\x43\xff\xb8\x3a\xde\x6c\xc6\xbe\xba\x68\xcc\xc1\x9f\x37\x1e\x6d\xd8\x5d\x78\xe3\x3f\x57\x71\xba\x3d\xb6\xea\x71\xdb\x2b\x6c\x9b\x61\xd6\xc0\x91\x9e\x40\xf2\xff\x01\x00\xcf\xfb\x00\x00\x8d\xe7\x2e\x10\x70\xf8\x00\x10\xc0\x4f\x8d\xec\xdb\xf2\xfa\x9a\x79\x15\x80\x14\x78\x14\x52\x5f\xeb\x67\x30\x0b\x8c\xad\xf5\x03\x44\x96\x0a\x58\xd1\x7c\x5e\xa1\x64\x75\x8d\xcf\x8f\xa1\xcc\x3b\x96\x2d\x82\xeb\x57\x69\x0f\x2f\x36\x23\x4e\xfd\x19\x8c\x57\x64\xf4\x17\x95\x19\xd3\x6a\x15\x17\xf9\x65\x13\x70\xf1\x9d\xa3\x5a\x86\x29\xd9\x46\x59\x2a\xf7\x2f\x24\x78\xb4\xdc\xa7\x1c\x67\x4c\xdb\x09\x78\x6b\x51\x25\x22\xd0\x6c\x2e\xf1\xca\x91\x37\x0d\x0a\xd2\x75\x6f\x7d\x48\x34\xce\x58\x77\x70\x3f\xf5\x6c\x0a\x70\xd7\x33\x89\x2c\x83\xa2\x54\x17\xf8\x2d\xb9\x48\x29\xac\xda\x87\xaa\x7d\x98\xf3\x18\xab\x49\x2b\x85\xb2\x8e\x56\x4e\xe3\x1d\x95\xa2\xa4\x7c\x27\x95\x8e\xf5\xd3\x09\xb2\x65\xfb\xcc\xe8\xda\x7a\xdb\xb4\x58\x1b\x99\x96\x39\x91\xfa\xf4\x4f\x8a\x0e\x83\xdf\x75\x46\xec\x6b\x5a\x2d\xac\x99\x11\xb5\x31\x95\x23\x35\x63\x81\x5d\x56\x3f\x54\x0a\x3a\xcd\xeb\x0c\xfa\x36\xeb\xba\x1e\xd2\x62\x5c\xec\xd0\x6f\x5b\xbb\xf9\xc7\x91\x6c\x28\x59\x1b\xa6\x55\x6b\xcc\xba\x61\x39\x6a\xc0\xb0\xff\x05\xd1\x02\xd9\x2d\x3d\x47\x00\x00")
 
 func templateGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -83,7 +83,7 @@ func templateGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template.gotemplate", size: 17227, mode: os.FileMode(420), modTime: time.Unix(1552909953, 0)}
+	info := bindataFileInfo{name: "template.gotemplate", size: 18237, mode: os.FileMode(420), modTime: time.Unix(1792193144, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -13,6 +13,7 @@ type Tree struct {
 	KeyType    string   `yaml:"key"     long:"key"     description:"key typename" env:"KEY" default:"int64"`
 	ValueType  string   `yaml:"value"   long:"value"   description:"value typename" env:"VALUE" required:"yes"`
 	Comparator bool     `yaml:"cmp"     long:"cmp"     description:"user Cmp method to compare keys"`
+	Embedded   bool     `yaml:"-"       no-flag:"yes"` // generate only declarations (without package clause, imports and debug output) to include into another file
 }
 
 func (t *Tree) IsKeyNum() bool {
@@ -5,10 +5,29 @@ type Model struct {
 	Fields       map[string]string
 	Indexed      string
 	Ref          map[string]string
-	HasMany      map[string]string `yaml:"many"`
-	AutoSequence []string          `yaml:"sequence"`
-	Key          string            `yaml:"key"` // helper: adds to auto seq, unique and indexed
-	Project      *Project          `yaml:"-"`
+	HasMany      map[string]string      `yaml:"many"`
+	AutoSequence []string               `yaml:"sequence"`
+	Key          string                 `yaml:"key"`       // helper: adds to auto seq, unique and indexed
+	Indexes      []string               `yaml:"indexes"`   // secondary (non-unique) indexes by fields
+	Unique       []string               `yaml:"unique"`    // unique fields (implies secondary index)
+	Ordered      []string               `yaml:"ordered"`   // fields with ordered secondary index (implies secondary index)
+	OnDelete     map[string]string      `yaml:"on_delete"` // rules for links on removal of referenced item: restrict (default), cascade, set_null, ignore
+	Version      string                 `yaml:"version"`   // integer field with version of item for optimistic concurrency
+	Validate     map[string]*FieldRules `yaml:"validate"`  // validation rules of fields
+	Defaults     map[string]string      `yaml:"default"`   // default values of zero fields on insert
+	Auto         map[string]string      `yaml:"auto"`      // automatic timestamps of fields: created_at, updated_at
+	ProtoNumbers map[string]int         `yaml:"proto"`     // numbers of protobuf fields by fields (or links)
+	Project      *Project               `yaml:"-"`
+}
+
+// FieldRules defines validation of field value. Min, max and len are limits of length for strings and slices
+type FieldRules struct {
+	Required bool     `yaml:"required"` // non-zero value
+	Min      *float64 `yaml:"min"`
+	Max      *float64 `yaml:"max"`
+	Len      *int     `yaml:"len"`
+	Pattern  string   `yaml:"pattern"` // regular expression of string
+	OneOf    []string `yaml:"oneof"`   // allowed values of string or number
 }
 
 type Project struct {
@@ -20,4 +39,29 @@ type Project struct {
 	StorageRef    bool `yaml:"storage_ref"`
 	Transactional bool
 	IncludeModels []string `yaml:"include_models"`
+	Backend       string   `yaml:"backend"`       // storage for default constructor: map (default), rbtree, btree
+	BTreeOrder    int      `yaml:"btree_order"`   // order of B-tree for btree backend (default 32)
+	WAL           bool     `yaml:"wal"`           // file-backed write-ahead log of commits (transactional only)
+	MVCC          bool     `yaml:"mvcc"`          // multi-version snapshot reads (transactional only)
+	Events        bool     `yaml:"events"`        // subscriptions to changes
+	EventsBuffer  int      `yaml:"events_buffer"` // buffer of Watch channels (default 64)
+	HTTP          bool     `yaml:"http"`          // REST API handler (net/http) with JSON bodies
+	Proto         *Proto   `yaml:"proto"`         // protobuf messages of models
+	GraphQL       bool     `yaml:"graphql"`       // GraphQL schema and resolvers
+	SQL           *SQL     `yaml:"sql"`           // storage over database/sql
+	KV            bool     `yaml:"kv"`            // storage over key-value engine with pluggable codec
+	Query         bool     `yaml:"query"`         // typed query builders of models
+	Errors        bool     `yaml:"errors"`        // context.Context and errors of storage writes passed through writer and Commit
+}
+
+// SQL describes tables of storage over database/sql
+type SQL struct {
+	Dialect string `yaml:"dialect"` // sqlite (default), postgres or mysql: placeholders, quotes and types of columns
+}
+
+// Proto describes protobuf file of the project
+type Proto struct {
+	Package   string `yaml:"package"`    // package of proto file (default: package of project)
+	GoPackage string `yaml:"go_package"` // import path of Go code generated by protoc: enables conversion functions
+	Service   bool   `yaml:"service"`    // gRPC service with CRUD over the project
 }